@@ -0,0 +1,208 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/the20100/shopify-admin-cli/internal/api"
+	"github.com/the20100/shopify-admin-cli/internal/output"
+)
+
+var abandonedCheckoutsCmd = &cobra.Command{
+	Use:   "abandoned-checkouts",
+	Short: "List abandoned checkouts and their recovery links",
+}
+
+// ---- abandoned-checkouts list ----
+
+var (
+	abandonedListSince    string
+	abandonedListQuery    string
+	abandonedListMinTotal string
+	abandonedListNoOrder  bool
+	abandonedListCSV      bool
+	abandonedListOutput   string
+)
+
+var abandonedCheckoutsListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List abandoned checkouts with customer, cart, and recovery URL",
+	Long: `List checkouts started since --since (default -7d) that were never completed,
+newest first, with the customer, cart contents, total, and the recovery URL that
+takes the customer back to their cart.
+
+  --min-total <amount>    only carts worth at least this much (shop currency)
+  --no-subsequent-order   only customers who haven't ordered since abandoning
+  --csv                   write a CSV for recovery campaigns (--output <file>)
+
+--query takes Shopify's abandoned checkout search syntax, e.g. email_state:subscribed.
+
+Examples:
+  shopify-admin abandoned-checkouts list
+  shopify-admin abandoned-checkouts list --since -30d --min-total 100
+  shopify-admin abandoned-checkouts list --since -3d --no-subsequent-order --csv --output recovery.csv`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		since, err := parseSince("--since", abandonedListSince)
+		if err != nil {
+			return err
+		}
+		var minTotal float64
+		if abandonedListMinTotal != "" {
+			if minTotal = parseAmount(abandonedListMinTotal); minTotal <= 0 {
+				return fmt.Errorf("invalid --min-total %q", abandonedListMinTotal)
+			}
+		}
+		query := "created_at:>='" + since.Format(time.RFC3339) + "'"
+		if abandonedListQuery != "" {
+			query += " " + abandonedListQuery
+		}
+
+		var checkouts []api.AbandonedCheckout
+		after := ""
+		for {
+			conn, err := client.ListAbandonedCheckouts(10, after, query)
+			if err != nil {
+				return err
+			}
+			for _, e := range conn.Edges {
+				c := e.Node
+				if c.CompletedAt != nil {
+					continue
+				}
+				if minTotal > 0 && parseAmount(c.TotalPriceSet.ShopMoney.Amount) < minTotal {
+					continue
+				}
+				if abandonedListNoOrder && orderedSince(c) {
+					continue
+				}
+				checkouts = append(checkouts, c)
+			}
+			if !conn.PageInfo.HasNextPage {
+				break
+			}
+			after = conn.PageInfo.EndCursor
+		}
+
+		if abandonedListCSV {
+			var w io.Writer = os.Stdout
+			if abandonedListOutput != "" {
+				f, err := os.Create(abandonedListOutput)
+				if err != nil {
+					return err
+				}
+				defer f.Close()
+				w = f
+			}
+			headers := []string{"checkout_id", "checkout", "created_at", "customer_id", "first_name", "last_name", "email", "items", "quantity", "subtotal", "total", "currency", "recovery_url"}
+			rows := make([][]string, len(checkouts))
+			for i, c := range checkouts {
+				var customerID, first, last, email string
+				if c.Customer != nil {
+					customerID, first, last, email = shortID(c.Customer.ID), c.Customer.FirstName, c.Customer.LastName, c.Customer.Email
+				}
+				rows[i] = []string{
+					shortID(c.ID),
+					c.Name,
+					c.CreatedAt,
+					customerID,
+					first,
+					last,
+					email,
+					checkoutItems(c),
+					fmt.Sprintf("%d", checkoutQuantity(c)),
+					c.SubtotalPriceSet.ShopMoney.Amount,
+					c.TotalPriceSet.ShopMoney.Amount,
+					c.TotalPriceSet.ShopMoney.CurrencyCode,
+					c.AbandonedCheckoutURL,
+				}
+			}
+			if err := output.WriteCSV(w, headers, rows); err != nil {
+				return err
+			}
+			if abandonedListOutput != "" {
+				fmt.Fprintf(os.Stderr, "Exported %d abandoned checkouts to %s.\n", len(checkouts), abandonedListOutput)
+			}
+			return nil
+		}
+		if output.IsJSON(cmd) {
+			if checkouts == nil {
+				checkouts = []api.AbandonedCheckout{}
+			}
+			return output.PrintJSON(checkouts, output.IsPretty(cmd))
+		}
+		if len(checkouts) == 0 {
+			fmt.Println("No abandoned checkouts found.")
+			return nil
+		}
+		headers := []string{"CREATED", "CHECKOUT", "CUSTOMER", "EMAIL", "ITEMS", "TOTAL", "RECOVERY URL"}
+		rows := make([][]string, len(checkouts))
+		for i, c := range checkouts {
+			customer, email := "-", "-"
+			if c.Customer != nil {
+				customer = orDash(strings.TrimSpace(c.Customer.FirstName + " " + c.Customer.LastName))
+				email = orDash(c.Customer.Email)
+			}
+			rows[i] = []string{
+				output.FormatTime(c.CreatedAt),
+				c.Name,
+				output.Truncate(customer, 20),
+				output.Truncate(email, 28),
+				output.Truncate(orDash(checkoutItems(c)), 36),
+				formatMoney(c.TotalPriceSet.ShopMoney.Amount, c.TotalPriceSet.ShopMoney.CurrencyCode),
+				orDash(c.AbandonedCheckoutURL),
+			}
+		}
+		output.PrintTable(headers, rows)
+		fmt.Printf("\n%d abandoned checkouts since %s.\n", len(checkouts), since.Format("2006-01-02"))
+		return nil
+	},
+}
+
+// orderedSince reports whether the checkout's customer placed an order after
+// abandoning it.
+func orderedSince(c api.AbandonedCheckout) bool {
+	if c.Customer == nil || c.Customer.LastOrder == nil {
+		return false
+	}
+	ordered, err1 := time.Parse(time.RFC3339, c.Customer.LastOrder.CreatedAt)
+	abandoned, err2 := time.Parse(time.RFC3339, c.CreatedAt)
+	return err1 == nil && err2 == nil && ordered.After(abandoned)
+}
+
+// checkoutItems summarises a checkout's cart as "2× Tee (M), 1× Mug".
+func checkoutItems(c api.AbandonedCheckout) string {
+	items := make([]string, len(c.LineItems.Edges))
+	for i, e := range c.LineItems.Edges {
+		li := e.Node
+		title := li.Title
+		if li.VariantTitle != "" {
+			title += " (" + li.VariantTitle + ")"
+		}
+		items[i] = fmt.Sprintf("%d× %s", li.Quantity, title)
+	}
+	return strings.Join(items, ", ")
+}
+
+func checkoutQuantity(c api.AbandonedCheckout) int {
+	n := 0
+	for _, e := range c.LineItems.Edges {
+		n += e.Node.Quantity
+	}
+	return n
+}
+
+func init() {
+	abandonedCheckoutsListCmd.Flags().StringVar(&abandonedListSince, "since", "-7d", "Checkouts created since: YYYY-MM-DD or a relative period like -7d")
+	abandonedCheckoutsListCmd.Flags().StringVar(&abandonedListQuery, "query", "", "Additional abandoned checkout search query")
+	abandonedCheckoutsListCmd.Flags().StringVar(&abandonedListMinTotal, "min-total", "", "Only checkouts with at least this total")
+	abandonedCheckoutsListCmd.Flags().BoolVar(&abandonedListNoOrder, "no-subsequent-order", false, "Only customers with no order after abandoning")
+	abandonedCheckoutsListCmd.Flags().BoolVar(&abandonedListCSV, "csv", false, "Write the list as CSV")
+	abandonedCheckoutsListCmd.Flags().StringVar(&abandonedListOutput, "output", "", "Write CSV to this file instead of stdout")
+
+	abandonedCheckoutsCmd.AddCommand(abandonedCheckoutsListCmd)
+	rootCmd.AddCommand(abandonedCheckoutsCmd)
+}
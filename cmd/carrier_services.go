@@ -0,0 +1,212 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/the20100/shopify-admin-cli/internal/api"
+	"github.com/the20100/shopify-admin-cli/internal/output"
+)
+
+var carrierServicesCmd = &cobra.Command{
+	Use:   "carrier-services",
+	Short: "Register and manage carrier-calculated shipping rate endpoints",
+}
+
+func printCarrierService(s *api.CarrierService) {
+	output.PrintKeyValue([][]string{
+		{"ID", shortID(s.ID)},
+		{"Name", s.Name},
+		{"Shown as", orDash(s.FormattedName)},
+		{"Callback URL", s.CallbackURL},
+		{"Active", yesNo(s.Active)},
+		{"Service discovery", yesNo(s.SupportsServiceDiscovery)},
+	})
+}
+
+var (
+	carrierServicesListFirst int
+	carrierServicesListAfter string
+)
+
+var carrierServicesListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List carrier services",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		conn, err := client.ListCarrierServices(carrierServicesListFirst, carrierServicesListAfter)
+		if err != nil {
+			return err
+		}
+		if output.IsJSON(cmd) {
+			items := make([]any, len(conn.Edges))
+			for i, e := range conn.Edges {
+				items[i] = e.Node
+			}
+			return output.PrintJSON(items, output.IsPretty(cmd))
+		}
+		if len(conn.Edges) == 0 {
+			fmt.Println("No carrier services found.")
+			return nil
+		}
+		headers := []string{"ID", "NAME", "ACTIVE", "DISCOVERY", "CALLBACK URL"}
+		rows := make([][]string, len(conn.Edges))
+		for i, e := range conn.Edges {
+			s := e.Node
+			rows[i] = []string{
+				shortID(s.ID),
+				s.Name,
+				yesNo(s.Active),
+				yesNo(s.SupportsServiceDiscovery),
+				output.Truncate(s.CallbackURL, 50),
+			}
+		}
+		output.PrintTable(headers, rows)
+		if conn.PageInfo.HasNextPage {
+			fmt.Printf("\n(more results — use --after %s)\n", conn.PageInfo.EndCursor)
+		}
+		return nil
+	},
+}
+
+var carrierServicesGetCmd = &cobra.Command{
+	Use:   "get <id>",
+	Short: "Get a carrier service",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := resolveID("DeliveryCarrierService", args[0])
+		if err != nil {
+			return err
+		}
+		s, err := client.GetCarrierService(id)
+		if err != nil {
+			return err
+		}
+		if output.IsJSON(cmd) {
+			return output.PrintJSON(s, output.IsPretty(cmd))
+		}
+		printCarrierService(s)
+		return nil
+	},
+}
+
+var (
+	carrierServiceName        string
+	carrierServiceCallbackURL string
+	carrierServiceActive      bool
+	carrierServiceDiscovery   bool
+)
+
+// addCarrierServiceFlags registers the flags shared by create and update.
+func addCarrierServiceFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&carrierServiceName, "name", "", "Name shown to customers at checkout")
+	cmd.Flags().StringVar(&carrierServiceCallbackURL, "callback-url", "", "URL Shopify calls for shipping rates")
+	cmd.Flags().BoolVar(&carrierServiceActive, "active", true, "Offer the service's rates at checkout")
+	cmd.Flags().BoolVar(&carrierServiceDiscovery, "service-discovery", true, "Let Shopify call the endpoint with a dummy request to list its services")
+}
+
+var carrierServicesCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Register a rate-calculation endpoint",
+	Long: `Register a carrier service. At checkout Shopify POSTs the cart and destination to
+--callback-url and shows the rates it returns.
+
+Examples:
+  shopify-admin carrier-services create --name "Acme Freight" --callback-url https://rates.example.com/shopify
+  shopify-admin carrier-services create --name "Acme Freight" --callback-url https://rates.example.com/shopify --active=false`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if carrierServiceName == "" {
+			return fmt.Errorf("--name is required")
+		}
+		if carrierServiceCallbackURL == "" {
+			return fmt.Errorf("--callback-url is required")
+		}
+		s, err := client.CreateCarrierService(carrierServiceName, carrierServiceCallbackURL, carrierServiceActive, carrierServiceDiscovery)
+		if err != nil {
+			return err
+		}
+		if output.IsJSON(cmd) {
+			return output.PrintJSON(s, output.IsPretty(cmd))
+		}
+		fmt.Println("Carrier service created")
+		printCarrierService(s)
+		return nil
+	},
+}
+
+var carrierServicesUpdateCmd = &cobra.Command{
+	Use:   "update <id>",
+	Short: "Update a carrier service",
+	Long: `Update a carrier service. Only the flags you pass are changed.
+
+Examples:
+  shopify-admin carrier-services update 1234567890 --callback-url https://rates2.example.com/shopify
+  shopify-admin carrier-services update 1234567890 --active=false`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		input := map[string]any{}
+		flags := cmd.Flags()
+		if flags.Changed("name") {
+			input["name"] = carrierServiceName
+		}
+		if flags.Changed("callback-url") {
+			input["callbackUrl"] = carrierServiceCallbackURL
+		}
+		if flags.Changed("active") {
+			input["active"] = carrierServiceActive
+		}
+		if flags.Changed("service-discovery") {
+			input["supportsServiceDiscovery"] = carrierServiceDiscovery
+		}
+		if len(input) == 0 {
+			return fmt.Errorf("nothing to update — pass --name, --callback-url, --active, or --service-discovery")
+		}
+		id, err := resolveID("DeliveryCarrierService", args[0])
+		if err != nil {
+			return err
+		}
+		s, err := client.UpdateCarrierService(id, input)
+		if err != nil {
+			return err
+		}
+		if output.IsJSON(cmd) {
+			return output.PrintJSON(s, output.IsPretty(cmd))
+		}
+		fmt.Println("Carrier service updated")
+		printCarrierService(s)
+		return nil
+	},
+}
+
+var carrierServicesDeleteCmd = &cobra.Command{
+	Use:   "delete <id>",
+	Short: "Delete a carrier service",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := resolveID("DeliveryCarrierService", args[0])
+		if err != nil {
+			return err
+		}
+		if err := client.DeleteCarrierService(id); err != nil {
+			return err
+		}
+		fmt.Printf("Carrier service %s deleted.\n", args[0])
+		return nil
+	},
+}
+
+func init() {
+	carrierServicesListCmd.Flags().IntVar(&carrierServicesListFirst, "first", 50, "Number of carrier services to return")
+	carrierServicesListCmd.Flags().StringVar(&carrierServicesListAfter, "after", "", "Pagination cursor")
+
+	addCarrierServiceFlags(carrierServicesCreateCmd)
+	addCarrierServiceFlags(carrierServicesUpdateCmd)
+
+	carrierServicesCmd.AddCommand(
+		carrierServicesListCmd,
+		carrierServicesGetCmd,
+		carrierServicesCreateCmd,
+		carrierServicesUpdateCmd,
+		carrierServicesDeleteCmd,
+	)
+	rootCmd.AddCommand(carrierServicesCmd)
+}
@@ -67,7 +67,11 @@ var collectionsGetCmd = &cobra.Command{
 	Short: "Get details of a specific collection",
 	Args:  cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
-		c, err := client.GetCollection(args[0])
+		id, err := resolveID("Collection", args[0])
+		if err != nil {
+			return err
+		}
+		c, err := client.GetCollection(id)
 		if err != nil {
 			return err
 		}
@@ -119,7 +123,11 @@ var collectionsUpdateCmd = &cobra.Command{
 	Short: "Update a collection",
 	Args:  cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
-		c, err := client.UpdateCollection(args[0], collectionUpdateTitle, collectionUpdateDesc)
+		id, err := resolveID("Collection", args[0])
+		if err != nil {
+			return err
+		}
+		c, err := client.UpdateCollection(id, collectionUpdateTitle, collectionUpdateDesc)
 		if err != nil {
 			return err
 		}
@@ -137,7 +145,11 @@ var collectionsDeleteCmd = &cobra.Command{
 	Short: "Delete a collection (irreversible)",
 	Args:  cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
-		if err := client.DeleteCollection(args[0]); err != nil {
+		id, err := resolveID("Collection", args[0])
+		if err != nil {
+			return err
+		}
+		if err := client.DeleteCollection(id); err != nil {
 			return err
 		}
 		fmt.Printf("Collection %s deleted.\n", args[0])
@@ -73,7 +73,11 @@ var customersGetCmd = &cobra.Command{
 	Short: "Get details of a specific customer",
 	Args:  cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
-		c, err := client.GetCustomer(args[0])
+		id, err := resolveID("Customer", args[0])
+		if err != nil {
+			return err
+		}
+		c, err := client.GetCustomer(id)
 		if err != nil {
 			return err
 		}
@@ -155,8 +159,12 @@ var customersUpdateCmd = &cobra.Command{
 	Short: "Update a customer",
 	Args:  cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := resolveID("Customer", args[0])
+		if err != nil {
+			return err
+		}
 		c, err := client.UpdateCustomer(
-			args[0],
+			id,
 			customerUpdateFirst,
 			customerUpdateLast,
 			customerUpdateEmail,
@@ -180,7 +188,11 @@ var customersDeleteCmd = &cobra.Command{
 	Short: "Delete a customer (irreversible)",
 	Args:  cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
-		if err := client.DeleteCustomer(args[0]); err != nil {
+		id, err := resolveID("Customer", args[0])
+		if err != nil {
+			return err
+		}
+		if err := client.DeleteCustomer(id); err != nil {
 			return err
 		}
 		fmt.Printf("Customer %s deleted.\n", args[0])
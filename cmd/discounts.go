@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"github.com/spf13/cobra"
+	"github.com/the20100/shopify-admin-cli/internal/api"
 	"github.com/the20100/shopify-admin-cli/internal/output"
 )
 
@@ -80,10 +81,24 @@ Examples:
   shopify-admin discounts deactivate 1234567890`,
 	Args: cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
+		ref := args[0]
+		switch api.InferRefType(ref) {
+		case "DiscountNode", "DiscountCodeNode", "DiscountAutomaticNode":
+			// A discount has the same numeric ID under each of its node types.
+			ref = shortID(ref)
+		}
+		codeID, err := resolveID("DiscountCodeNode", ref)
+		if err != nil {
+			return err
+		}
+		automaticID, err := resolveID("DiscountAutomaticNode", ref)
+		if err != nil {
+			return err
+		}
 		// Try code discount first; if that fails, try automatic
-		err := client.DeactivateDiscountCode(args[0])
+		err = client.DeactivateDiscountCode(codeID)
 		if err != nil {
-			err2 := client.DeactivateAutomaticDiscount(args[0])
+			err2 := client.DeactivateAutomaticDiscount(automaticID)
 			if err2 != nil {
 				return fmt.Errorf("code discount: %v; automatic discount: %v", err, err2)
 			}
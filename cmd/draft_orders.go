@@ -0,0 +1,535 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/the20100/shopify-admin-cli/internal/api"
+	"github.com/the20100/shopify-admin-cli/internal/output"
+)
+
+var draftOrdersCmd = &cobra.Command{
+	Use:   "draft-orders",
+	Short: "Manage Shopify draft orders",
+}
+
+// draftOrderInputFlags holds the flags shared by draft-orders create and update.
+type draftOrderInputFlags struct {
+	file                string
+	items               []string
+	customItems         []string
+	customer            string
+	email               string
+	shippingAddressFile string
+	discount            string
+	discountTitle       string
+	tags                string
+	note                string
+}
+
+var (
+	draftOrderCreateFlags draftOrderInputFlags
+	draftOrderUpdateFlags draftOrderInputFlags
+)
+
+func (f *draftOrderInputFlags) register(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&f.file, "file", "", "DraftOrderInput as YAML or JSON; flags are applied on top")
+	cmd.Flags().StringArrayVar(&f.items, "item", nil, "Line item: <variant>:<qty>, variant ID or SKU (repeatable)")
+	cmd.Flags().StringArrayVar(&f.customItems, "custom-item", nil, "Custom line item: <title>:<price>:<qty> (repeatable)")
+	cmd.Flags().StringVar(&f.customer, "customer", "", "Customer ID or email")
+	cmd.Flags().StringVar(&f.email, "email", "", "Email for the order and invoice")
+	cmd.Flags().StringVar(&f.shippingAddressFile, "shipping-address-file", "", "Shipping address (MailingAddressInput) as YAML or JSON")
+	cmd.Flags().StringVar(&f.discount, "discount", "", "Order discount: 10% or a fixed amount such as 5.00")
+	cmd.Flags().StringVar(&f.discountTitle, "discount-title", "", "Title shown for --discount")
+	cmd.Flags().StringVar(&f.tags, "tags", "", "Comma-separated tags")
+	cmd.Flags().StringVar(&f.note, "note", "", "Note on the draft order")
+}
+
+// variantRef treats a bare, non-numeric reference as a SKU.
+func variantRef(ref string) string {
+	if isNumeric(ref) || strings.HasPrefix(ref, "gid://") || strings.Contains(ref, ":") {
+		return ref
+	}
+	return "sku:" + ref
+}
+
+// build assembles a DraftOrderInput from --file and the individual flags.
+func (f *draftOrderInputFlags) build() (map[string]any, error) {
+	input := map[string]any{}
+	if f.file != "" {
+		if err := loadSpecFile(f.file, &input); err != nil {
+			return nil, err
+		}
+	}
+
+	var lineItems []any
+	if raw, ok := input["lineItems"]; ok {
+		list, ok := raw.([]any)
+		if !ok {
+			return nil, fmt.Errorf("%s: lineItems must be a list", f.file)
+		}
+		// Let file line items reference variants by SKU or resolver ref instead of GID.
+		for i, item := range list {
+			m, ok := item.(map[string]any)
+			if !ok {
+				return nil, fmt.Errorf("%s: lineItems[%d] must be an object", f.file, i)
+			}
+			ref := ""
+			if sku, ok := m["sku"].(string); ok {
+				ref = "sku:" + sku
+				delete(m, "sku")
+			} else if v, ok := m["variant"]; ok {
+				ref = variantRef(fmt.Sprint(v))
+				delete(m, "variant")
+			}
+			if ref != "" {
+				id, err := resolveID("ProductVariant", ref)
+				if err != nil {
+					return nil, fmt.Errorf("%s: lineItems[%d]: %w", f.file, i, err)
+				}
+				m["variantId"] = id
+			}
+			lineItems = append(lineItems, m)
+		}
+	}
+	for _, spec := range f.items {
+		ref, qty, err := splitQuantitySpec(spec)
+		if err != nil {
+			return nil, fmt.Errorf("--item: %w", err)
+		}
+		if qty == 0 {
+			return nil, fmt.Errorf("--item %q: quantity must be at least 1", spec)
+		}
+		id, err := resolveID("ProductVariant", variantRef(ref))
+		if err != nil {
+			return nil, err
+		}
+		lineItems = append(lineItems, map[string]any{"variantId": id, "quantity": qty})
+	}
+	for _, spec := range f.customItems {
+		rest, qty, err := splitQuantitySpec(spec)
+		if err != nil {
+			return nil, fmt.Errorf("--custom-item: %w", err)
+		}
+		if qty == 0 {
+			return nil, fmt.Errorf("--custom-item %q: quantity must be at least 1", spec)
+		}
+		title, price, err := splitSpec(rest)
+		if err != nil {
+			return nil, fmt.Errorf("--custom-item %q: expected <title>:<price>:<qty>", spec)
+		}
+		if _, err := strconv.ParseFloat(price, 64); err != nil {
+			return nil, fmt.Errorf("--custom-item %q: invalid price %q", spec, price)
+		}
+		lineItems = append(lineItems, map[string]any{"title": title, "originalUnitPrice": price, "quantity": qty})
+	}
+	if len(lineItems) > 0 {
+		input["lineItems"] = lineItems
+	}
+
+	if f.customer != "" {
+		id, err := resolveID("Customer", f.customer)
+		if err != nil {
+			return nil, err
+		}
+		input["purchasingEntity"] = map[string]any{"customerId": id}
+	}
+	if f.email != "" {
+		input["email"] = f.email
+	}
+	if f.shippingAddressFile != "" {
+		var addr map[string]any
+		if err := loadSpecFile(f.shippingAddressFile, &addr); err != nil {
+			return nil, err
+		}
+		input["shippingAddress"] = addr
+	}
+	if f.discount != "" {
+		discount, err := draftOrderDiscount(f.discount, f.discountTitle)
+		if err != nil {
+			return nil, err
+		}
+		input["appliedDiscount"] = discount
+	}
+	if f.tags != "" {
+		input["tags"] = splitTags(f.tags)
+	}
+	if f.note != "" {
+		input["note"] = f.note
+	}
+	return input, nil
+}
+
+// draftOrderDiscount parses "10%" or "5.00" into a DraftOrderAppliedDiscountInput.
+func draftOrderDiscount(value, title string) (map[string]any, error) {
+	valueType := "FIXED_AMOUNT"
+	raw := value
+	if pct, ok := strings.CutSuffix(value, "%"); ok {
+		valueType, raw = "PERCENTAGE", pct
+	}
+	f, err := strconv.ParseFloat(raw, 64)
+	if err != nil || f <= 0 {
+		return nil, fmt.Errorf("invalid --discount %q: expected e.g. 10%% or 5.00", value)
+	}
+	if title == "" {
+		title = value + " off"
+	}
+	return map[string]any{"value": f, "valueType": valueType, "title": title}, nil
+}
+
+func draftOrderCustomerName(d api.DraftOrder) string {
+	if d.Customer == nil {
+		return "-"
+	}
+	name := strings.TrimSpace(d.Customer.FirstName + " " + d.Customer.LastName)
+	if name == "" {
+		return d.Customer.Email
+	}
+	return name
+}
+
+func printDraftOrder(d *api.DraftOrder) {
+	money := func(b api.MoneyBag) string {
+		return formatMoney(b.ShopMoney.Amount, b.ShopMoney.CurrencyCode)
+	}
+	discount := "-"
+	if d.AppliedDiscount != nil {
+		discount = fmt.Sprintf("%s (%s)", money(d.AppliedDiscount.AmountSet), d.AppliedDiscount.Title)
+	}
+	shippingAddr := "-"
+	if d.ShippingAddress != nil {
+		a := d.ShippingAddress
+		shippingAddr = fmt.Sprintf("%s %s, %s, %s %s %s",
+			a.FirstName, a.LastName, a.Address1, a.City, a.Province, a.Country)
+	}
+	order := "-"
+	if d.Order != nil {
+		order = d.Order.Name
+	}
+	output.PrintKeyValue([][]string{
+		{"ID", shortID(d.ID)},
+		{"Name", d.Name},
+		{"Status", strings.ToLower(d.Status)},
+		{"Customer", draftOrderCustomerName(*d)},
+		{"Email", orDash(d.Email)},
+		{"Subtotal", money(d.SubtotalPriceSet)},
+		{"Discount", discount},
+		{"Shipping", money(d.TotalShippingPriceSet)},
+		{"Tax", money(d.TotalTaxSet)},
+		{"Total", money(d.TotalPriceSet)},
+		{"Ship to", shippingAddr},
+		{"Note", orDash(d.Note)},
+		{"Tags", output.FormatLabels(d.Tags)},
+		{"Invoice URL", orDash(d.InvoiceURL)},
+		{"Invoice sent", output.FormatTime(d.InvoiceSentAt)},
+		{"Order", order},
+		{"Created", output.FormatTime(d.CreatedAt)},
+	})
+	if len(d.LineItems.Edges) > 0 {
+		fmt.Println()
+		fmt.Println("Line items:")
+		headers := []string{"TITLE", "SKU", "QTY", "PRICE", "TOTAL"}
+		rows := make([][]string, len(d.LineItems.Edges))
+		for i, e := range d.LineItems.Edges {
+			li := e.Node
+			sku := li.SKU
+			if li.Custom {
+				sku = "(custom)"
+			}
+			rows[i] = []string{
+				output.Truncate(li.Title, 40),
+				sku,
+				fmt.Sprintf("%d", li.Quantity),
+				money(li.OriginalUnitPriceSet),
+				money(li.DiscountedTotalSet),
+			}
+		}
+		output.PrintTable(headers, rows)
+	}
+}
+
+// ---- draft-orders list ----
+
+var (
+	draftOrdersListFirst int
+	draftOrdersListAfter string
+	draftOrdersListQuery string
+)
+
+var draftOrdersListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List draft orders",
+	Long: `List draft orders, newest first.
+
+Use --query for Shopify search syntax, e.g.: status:open, status:invoice_sent, tag:wholesale
+
+Examples:
+  shopify-admin draft-orders list
+  shopify-admin draft-orders list --query "status:open tag:wholesale"
+  shopify-admin draft-orders list --first 20 --json`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		conn, err := client.ListDraftOrders(draftOrdersListFirst, draftOrdersListAfter, draftOrdersListQuery)
+		if err != nil {
+			return err
+		}
+		if output.IsJSON(cmd) {
+			items := make([]any, len(conn.Edges))
+			for i, e := range conn.Edges {
+				items[i] = e.Node
+			}
+			return output.PrintJSON(items, output.IsPretty(cmd))
+		}
+		if len(conn.Edges) == 0 {
+			fmt.Println("No draft orders found.")
+			return nil
+		}
+		headers := []string{"ID", "NAME", "STATUS", "TOTAL", "CUSTOMER", "ORDER", "CREATED"}
+		rows := make([][]string, len(conn.Edges))
+		for i, e := range conn.Edges {
+			d := e.Node
+			order := "-"
+			if d.Order != nil {
+				order = d.Order.Name
+			}
+			rows[i] = []string{
+				shortID(d.ID),
+				d.Name,
+				strings.ToLower(d.Status),
+				formatMoney(d.TotalPriceSet.ShopMoney.Amount, d.TotalPriceSet.ShopMoney.CurrencyCode),
+				output.Truncate(draftOrderCustomerName(d), 24),
+				order,
+				output.FormatTime(d.CreatedAt),
+			}
+		}
+		output.PrintTable(headers, rows)
+		if conn.PageInfo.HasNextPage {
+			fmt.Printf("\n(more results — use --after %s)\n", conn.PageInfo.EndCursor)
+		}
+		return nil
+	},
+}
+
+// ---- draft-orders get ----
+
+var draftOrdersGetCmd = &cobra.Command{
+	Use:   "get <id>",
+	Short: "Get details of a specific draft order",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := resolveID("DraftOrder", args[0])
+		if err != nil {
+			return err
+		}
+		d, err := client.GetDraftOrder(id)
+		if err != nil {
+			return err
+		}
+		if output.IsJSON(cmd) {
+			return output.PrintJSON(d, output.IsPretty(cmd))
+		}
+		printDraftOrder(d)
+		return nil
+	},
+}
+
+// ---- draft-orders create ----
+
+var draftOrdersCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create a draft order",
+	Long: `Create a draft order from flags, a YAML/JSON DraftOrderInput file, or both
+(flags are applied on top of the file; --item and --custom-item add to its line items).
+
+Line items in a file may use "sku: ABC-1" or "variant: <ref>" instead of variantId.
+
+Examples:
+  shopify-admin draft-orders create --customer buyer@wholesale.com --item SKU-001:24 --item SKU-002:12
+  shopify-admin draft-orders create --item 4567:1 --custom-item "Engraving:15.00:1" --discount 10%
+  shopify-admin draft-orders create --file wholesale-order.yaml --tags wholesale
+  shopify-admin draft-orders create --item SKU-001:5 --shipping-address-file address.yaml`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		input, err := draftOrderCreateFlags.build()
+		if err != nil {
+			return err
+		}
+		if _, ok := input["lineItems"]; !ok {
+			return fmt.Errorf("at least one line item is required — pass --item, --custom-item, or --file")
+		}
+		d, err := client.CreateDraftOrder(input)
+		if err != nil {
+			return err
+		}
+		if output.IsJSON(cmd) {
+			return output.PrintJSON(d, output.IsPretty(cmd))
+		}
+		fmt.Printf("Draft order created: %s\n", d.Name)
+		fmt.Printf("ID:      %s\n", shortID(d.ID))
+		fmt.Printf("Total:   %s\n", formatMoney(d.TotalPriceSet.ShopMoney.Amount, d.TotalPriceSet.ShopMoney.CurrencyCode))
+		fmt.Printf("Invoice: %s\n", orDash(d.InvoiceURL))
+		return nil
+	},
+}
+
+// ---- draft-orders update ----
+
+var draftOrdersUpdateCmd = &cobra.Command{
+	Use:   "update <id>",
+	Short: "Update a draft order",
+	Long: `Update a draft order. Takes the same flags as create.
+
+Passing any line items (--item, --custom-item, or lineItems in --file) replaces
+all existing line items.
+
+Examples:
+  shopify-admin draft-orders update 12345 --tags "wholesale,net30" --note "Ship with pallet"
+  shopify-admin draft-orders update "#D12" --item SKU-001:48 --discount 15%`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := resolveID("DraftOrder", args[0])
+		if err != nil {
+			return err
+		}
+		input, err := draftOrderUpdateFlags.build()
+		if err != nil {
+			return err
+		}
+		if len(input) == 0 {
+			return fmt.Errorf("nothing to update")
+		}
+		d, err := client.UpdateDraftOrder(id, input)
+		if err != nil {
+			return err
+		}
+		if output.IsJSON(cmd) {
+			return output.PrintJSON(d, output.IsPretty(cmd))
+		}
+		fmt.Printf("Draft order updated: %s\n", d.Name)
+		fmt.Printf("ID:    %s\n", shortID(d.ID))
+		fmt.Printf("Total: %s\n", formatMoney(d.TotalPriceSet.ShopMoney.Amount, d.TotalPriceSet.ShopMoney.CurrencyCode))
+		return nil
+	},
+}
+
+// ---- draft-orders delete ----
+
+var draftOrdersDeleteCmd = &cobra.Command{
+	Use:   "delete <id>",
+	Short: "Delete a draft order (irreversible)",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := resolveID("DraftOrder", args[0])
+		if err != nil {
+			return err
+		}
+		if err := client.DeleteDraftOrder(id); err != nil {
+			return err
+		}
+		fmt.Printf("Draft order %s deleted.\n", args[0])
+		return nil
+	},
+}
+
+// ---- draft-orders complete ----
+
+var draftOrderCompletePaymentPending bool
+
+var draftOrdersCompleteCmd = &cobra.Command{
+	Use:   "complete <id>",
+	Short: "Convert a draft order into an order",
+	Long: `Complete a draft order, creating a real order from it.
+
+By default the order is marked as paid. Use --payment-pending for orders that will
+be paid later (e.g. wholesale orders on net terms).
+
+Examples:
+  shopify-admin draft-orders complete 12345
+  shopify-admin draft-orders complete "#D12" --payment-pending`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := resolveID("DraftOrder", args[0])
+		if err != nil {
+			return err
+		}
+		d, err := client.CompleteDraftOrder(id, draftOrderCompletePaymentPending)
+		if err != nil {
+			return err
+		}
+		if output.IsJSON(cmd) {
+			return output.PrintJSON(d, output.IsPretty(cmd))
+		}
+		order := "-"
+		if d.Order != nil {
+			order = fmt.Sprintf("%s (%s)", d.Order.Name, shortID(d.Order.ID))
+		}
+		fmt.Printf("Draft order %s completed.\n", d.Name)
+		fmt.Printf("Order: %s\n", order)
+		fmt.Printf("Total: %s\n", formatMoney(d.TotalPriceSet.ShopMoney.Amount, d.TotalPriceSet.ShopMoney.CurrencyCode))
+		return nil
+	},
+}
+
+// ---- draft-orders send-invoice ----
+
+var (
+	draftOrderInvoiceTo      string
+	draftOrderInvoiceSubject string
+	draftOrderInvoiceMessage string
+)
+
+var draftOrdersSendInvoiceCmd = &cobra.Command{
+	Use:   "send-invoice <id>",
+	Short: "Email the draft order invoice to the customer",
+	Long: `Email the draft order invoice with a checkout link.
+
+Examples:
+  shopify-admin draft-orders send-invoice 12345
+  shopify-admin draft-orders send-invoice "#D12" --to buyer@wholesale.com --message "Net 30 terms apply"`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := resolveID("DraftOrder", args[0])
+		if err != nil {
+			return err
+		}
+		d, err := client.SendDraftOrderInvoice(id, draftOrderInvoiceTo, draftOrderInvoiceSubject, draftOrderInvoiceMessage)
+		if err != nil {
+			return err
+		}
+		if output.IsJSON(cmd) {
+			return output.PrintJSON(d, output.IsPretty(cmd))
+		}
+		to := draftOrderInvoiceTo
+		if to == "" {
+			to = d.Email
+		}
+		fmt.Printf("Invoice for %s sent to %s.\n", d.Name, orDash(to))
+		return nil
+	},
+}
+
+func init() {
+	draftOrdersListCmd.Flags().IntVar(&draftOrdersListFirst, "first", 50, "Number of draft orders to return")
+	draftOrdersListCmd.Flags().StringVar(&draftOrdersListAfter, "after", "", "Pagination cursor")
+	draftOrdersListCmd.Flags().StringVar(&draftOrdersListQuery, "query", "", "Shopify search query")
+
+	draftOrderCreateFlags.register(draftOrdersCreateCmd)
+	draftOrderUpdateFlags.register(draftOrdersUpdateCmd)
+
+	draftOrdersCompleteCmd.Flags().BoolVar(&draftOrderCompletePaymentPending, "payment-pending", false, "Create the order as unpaid")
+
+	draftOrdersSendInvoiceCmd.Flags().StringVar(&draftOrderInvoiceTo, "to", "", "Recipient (defaults to the draft order email)")
+	draftOrdersSendInvoiceCmd.Flags().StringVar(&draftOrderInvoiceSubject, "subject", "", "Email subject")
+	draftOrdersSendInvoiceCmd.Flags().StringVar(&draftOrderInvoiceMessage, "message", "", "Custom message included in the email")
+
+	draftOrdersCmd.AddCommand(
+		draftOrdersListCmd,
+		draftOrdersGetCmd,
+		draftOrdersCreateCmd,
+		draftOrdersUpdateCmd,
+		draftOrdersDeleteCmd,
+		draftOrdersCompleteCmd,
+		draftOrdersSendInvoiceCmd,
+	)
+	rootCmd.AddCommand(draftOrdersCmd)
+}
@@ -0,0 +1,271 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/the20100/shopify-admin-cli/internal/api"
+	"github.com/the20100/shopify-admin-cli/internal/output"
+)
+
+var fulfillmentServicesCmd = &cobra.Command{
+	Use:   "fulfillment-services",
+	Short: "Register and manage fulfillment services (3PL and own-warehouse apps)",
+}
+
+func printFulfillmentService(s *api.FulfillmentService) {
+	location := "-"
+	if s.Location != nil {
+		location = s.Location.Name + " (" + shortID(s.Location.ID) + ")"
+	}
+	output.PrintKeyValue([][]string{
+		{"ID", shortID(s.ID)},
+		{"Name", s.ServiceName},
+		{"Handle", s.Handle},
+		{"Type", strings.ToLower(s.Type)},
+		{"Callback URL", orDash(s.CallbackURL)},
+		{"Location", location},
+		{"Inventory management", yesNo(s.InventoryManagement)},
+		{"Tracking support", yesNo(s.TrackingSupport)},
+		{"SKU sharing", yesNo(s.PermitsSkuSharing)},
+		{"Requires shipping method", yesNo(s.RequiresShippingMethod)},
+	})
+}
+
+// fulfillmentServiceFlags collects the service flags that were passed on the
+// command line, keyed by mutation argument.
+func fulfillmentServiceFlags(cmd *cobra.Command) map[string]any {
+	fields := map[string]any{}
+	flags := cmd.Flags()
+	if flags.Changed("callback-url") {
+		fields["callbackUrl"] = fulfillmentServiceCallbackURL
+	}
+	if flags.Changed("inventory-management") {
+		fields["inventoryManagement"] = fulfillmentServiceInventory
+	}
+	if flags.Changed("tracking-support") {
+		fields["trackingSupport"] = fulfillmentServiceTracking
+	}
+	if flags.Changed("permits-sku-sharing") {
+		fields["permitsSkuSharing"] = fulfillmentServiceSkuSharing
+	}
+	if flags.Changed("requires-shipping-method") {
+		fields["requiresShippingMethod"] = fulfillmentServiceShippingMethod
+	}
+	return fields
+}
+
+var (
+	fulfillmentServiceName           string
+	fulfillmentServiceCallbackURL    string
+	fulfillmentServiceInventory      bool
+	fulfillmentServiceTracking       bool
+	fulfillmentServiceSkuSharing     bool
+	fulfillmentServiceShippingMethod bool
+)
+
+// addFulfillmentServiceFlags registers the flags shared by create and update.
+func addFulfillmentServiceFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&fulfillmentServiceName, "name", "", "Service name shown in the admin")
+	cmd.Flags().StringVar(&fulfillmentServiceCallbackURL, "callback-url", "", "URL that receives fulfillment, tracking, and stock requests")
+	cmd.Flags().BoolVar(&fulfillmentServiceInventory, "inventory-management", false, "Shopify asks the service for stock levels")
+	cmd.Flags().BoolVar(&fulfillmentServiceTracking, "tracking-support", false, "Shopify asks the service for tracking numbers")
+	cmd.Flags().BoolVar(&fulfillmentServiceSkuSharing, "permits-sku-sharing", false, "Other locations may stock the same SKUs")
+	cmd.Flags().BoolVar(&fulfillmentServiceShippingMethod, "requires-shipping-method", false, "Orders must have a shipping method")
+}
+
+// ---- fulfillment-services list / get ----
+
+var fulfillmentServicesListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List fulfillment services",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		services, err := client.ListFulfillmentServices()
+		if err != nil {
+			return err
+		}
+		if output.IsJSON(cmd) {
+			return output.PrintJSON(services, output.IsPretty(cmd))
+		}
+		if len(services) == 0 {
+			fmt.Println("No fulfillment services found.")
+			return nil
+		}
+		headers := []string{"ID", "NAME", "TYPE", "LOCATION", "INVENTORY", "TRACKING", "CALLBACK URL"}
+		rows := make([][]string, len(services))
+		for i, s := range services {
+			location := "-"
+			if s.Location != nil {
+				location = s.Location.Name
+			}
+			rows[i] = []string{
+				shortID(s.ID),
+				s.ServiceName,
+				strings.ToLower(s.Type),
+				output.Truncate(location, 24),
+				yesNo(s.InventoryManagement),
+				yesNo(s.TrackingSupport),
+				output.Truncate(orDash(s.CallbackURL), 50),
+			}
+		}
+		output.PrintTable(headers, rows)
+		return nil
+	},
+}
+
+var fulfillmentServicesGetCmd = &cobra.Command{
+	Use:   "get <id>",
+	Short: "Get a fulfillment service",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := resolveID("FulfillmentService", args[0])
+		if err != nil {
+			return err
+		}
+		s, err := client.GetFulfillmentService(id)
+		if err != nil {
+			return err
+		}
+		if output.IsJSON(cmd) {
+			return output.PrintJSON(s, output.IsPretty(cmd))
+		}
+		printFulfillmentService(s)
+		return nil
+	},
+}
+
+// ---- fulfillment-services create / update ----
+
+var fulfillmentServicesCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Register a fulfillment service",
+	Long: `Register a fulfillment service. Shopify creates a location for it; products
+stocked there are routed to the service for fulfillment.
+
+Shopify sends fulfillment order notifications to --callback-url, and, when enabled,
+calls <callback-url>/fetch_tracking_numbers and <callback-url>/fetch_stock.
+
+Examples:
+  shopify-admin fulfillment-services create --name "Acme Warehouse" --callback-url https://wms.example.com/shopify
+  shopify-admin fulfillment-services create --name "Acme Warehouse" --callback-url https://wms.example.com/shopify --tracking-support --inventory-management`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if fulfillmentServiceName == "" {
+			return fmt.Errorf("--name is required")
+		}
+		s, err := client.CreateFulfillmentService(fulfillmentServiceName, fulfillmentServiceFlags(cmd))
+		if err != nil {
+			return err
+		}
+		if output.IsJSON(cmd) {
+			return output.PrintJSON(s, output.IsPretty(cmd))
+		}
+		fmt.Println("Fulfillment service created")
+		printFulfillmentService(s)
+		return nil
+	},
+}
+
+var fulfillmentServicesUpdateCmd = &cobra.Command{
+	Use:   "update <id>",
+	Short: "Update a fulfillment service",
+	Long: `Update a fulfillment service. Only the flags you pass are changed; turn a
+setting off with e.g. --tracking-support=false.
+
+Examples:
+  shopify-admin fulfillment-services update 1234567890 --callback-url https://wms2.example.com/shopify
+  shopify-admin fulfillment-services update 1234567890 --inventory-management=false`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		fields := fulfillmentServiceFlags(cmd)
+		if cmd.Flags().Changed("name") {
+			fields["name"] = fulfillmentServiceName
+		}
+		if len(fields) == 0 {
+			return fmt.Errorf("nothing to update — pass --name, --callback-url, or a support flag")
+		}
+		id, err := resolveID("FulfillmentService", args[0])
+		if err != nil {
+			return err
+		}
+		s, err := client.UpdateFulfillmentService(id, fields)
+		if err != nil {
+			return err
+		}
+		if output.IsJSON(cmd) {
+			return output.PrintJSON(s, output.IsPretty(cmd))
+		}
+		fmt.Println("Fulfillment service updated")
+		printFulfillmentService(s)
+		return nil
+	},
+}
+
+// ---- fulfillment-services delete ----
+
+var (
+	fulfillmentServiceDeleteInventory   string
+	fulfillmentServiceDeleteDestination string
+)
+
+var fulfillmentServicesDeleteCmd = &cobra.Command{
+	Use:   "delete <id>",
+	Short: "Delete a fulfillment service",
+	Long: `Delete a fulfillment service. Its location's inventory is handled by --inventory:
+
+  transfer   move it to --destination <location-id>
+  keep       keep the location as a merchant-managed location
+  delete     delete the location and its inventory
+
+Examples:
+  shopify-admin fulfillment-services delete 1234567890 --inventory keep
+  shopify-admin fulfillment-services delete 1234567890 --inventory transfer --destination 55555555`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		action := strings.ToUpper(fulfillmentServiceDeleteInventory)
+		switch action {
+		case "", "KEEP", "DELETE":
+			if fulfillmentServiceDeleteDestination != "" {
+				return fmt.Errorf("--destination only applies to --inventory transfer")
+			}
+		case "TRANSFER":
+			if fulfillmentServiceDeleteDestination == "" {
+				return fmt.Errorf("--inventory transfer requires --destination")
+			}
+		default:
+			return fmt.Errorf("invalid --inventory %q: expected transfer, keep, or delete", fulfillmentServiceDeleteInventory)
+		}
+		id, err := resolveID("FulfillmentService", args[0])
+		if err != nil {
+			return err
+		}
+		destination := ""
+		if fulfillmentServiceDeleteDestination != "" {
+			if destination, err = resolveID("Location", fulfillmentServiceDeleteDestination); err != nil {
+				return err
+			}
+		}
+		if err := client.DeleteFulfillmentService(id, action, destination); err != nil {
+			return err
+		}
+		fmt.Printf("Fulfillment service %s deleted.\n", args[0])
+		return nil
+	},
+}
+
+func init() {
+	addFulfillmentServiceFlags(fulfillmentServicesCreateCmd)
+	addFulfillmentServiceFlags(fulfillmentServicesUpdateCmd)
+
+	fulfillmentServicesDeleteCmd.Flags().StringVar(&fulfillmentServiceDeleteInventory, "inventory", "", "What happens to the location's inventory: transfer, keep, or delete")
+	fulfillmentServicesDeleteCmd.Flags().StringVar(&fulfillmentServiceDeleteDestination, "destination", "", "Location ID receiving inventory with --inventory transfer")
+
+	fulfillmentServicesCmd.AddCommand(
+		fulfillmentServicesListCmd,
+		fulfillmentServicesGetCmd,
+		fulfillmentServicesCreateCmd,
+		fulfillmentServicesUpdateCmd,
+		fulfillmentServicesDeleteCmd,
+	)
+	rootCmd.AddCommand(fulfillmentServicesCmd)
+}
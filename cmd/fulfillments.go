@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"github.com/spf13/cobra"
+	"github.com/the20100/shopify-admin-cli/internal/api"
 	"github.com/the20100/shopify-admin-cli/internal/output"
 )
 
@@ -23,7 +24,11 @@ Examples:
   shopify-admin fulfillments list 1234567890 --json`,
 	Args: cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
-		conn, err := client.ListFulfillmentOrders(args[0])
+		id, err := resolveID("Order", args[0])
+		if err != nil {
+			return err
+		}
+		conn, err := client.ListFulfillmentOrders(id)
 		if err != nil {
 			return err
 		}
@@ -38,7 +43,7 @@ Examples:
 			fmt.Println("No fulfillment orders found.")
 			return nil
 		}
-		headers := []string{"ID", "STATUS", "REQUEST STATUS", "LOCATION", "FULFILL AT"}
+		headers := []string{"ID", "STATUS", "REQUEST STATUS", "LOCATION", "FULFILL AT", "HOLDS"}
 		rows := make([][]string, len(conn.Edges))
 		for i, e := range conn.Edges {
 			fo := e.Node
@@ -48,6 +53,7 @@ Examples:
 				strings.ToLower(fo.RequestStatus),
 				fo.AssignedLocation.Name,
 				output.FormatTime(fo.FulfillAt),
+				holdReasons(fo.FulfillmentHolds),
 			}
 		}
 		output.PrintTable(headers, rows)
@@ -59,20 +65,43 @@ var (
 	fulfillmentCreateTracking string
 	fulfillmentCreateNumber   string
 	fulfillmentCreateURL      string
+	fulfillmentCreateLines    []string
+	fulfillmentCreateNotify   bool
 )
 
 var fulfillmentsCreateCmd = &cobra.Command{
 	Use:   "create <fulfillment-order-id>",
 	Short: "Create a fulfillment for a fulfillment order",
-	Long: `Create a fulfillment for all line items of a fulfillment order.
+	Long: `Create a fulfillment for a fulfillment order. By default every remaining line
+item is fulfilled; with --line, only those quantities ship and the rest stay open
+on the fulfillment order for a later shipment.
+
+--line takes <fo-line-item-id|line-item-id|sku>:<qty> and may be repeated.
+Remaining quantities are shown afterwards.
 
 Examples:
   shopify-admin fulfillments create 1234567890
-  shopify-admin fulfillments create 1234567890 --tracking UPS --number 1Z999AA1234567890
+  shopify-admin fulfillments create 1234567890 --tracking UPS --number 1Z999AA1234567890 --notify-customer
+  shopify-admin fulfillments create 1234567890 --line sku:MUG-01:2 --line 987654321:1
   shopify-admin fulfillments create 1234567890 --tracking FedEx --number 123456789 --url https://track.example.com`,
 	Args: cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
-		f, err := client.CreateFulfillment(args[0], fulfillmentCreateTracking, fulfillmentCreateNumber, fulfillmentCreateURL)
+		id, err := resolveID("FulfillmentOrder", args[0])
+		if err != nil {
+			return err
+		}
+		var lines []api.FulfillmentOrderLineInput
+		if len(fulfillmentCreateLines) > 0 {
+			fo, err := client.GetFulfillmentOrder(id)
+			if err != nil {
+				return err
+			}
+			if lines, err = fulfillmentOrderLines(fo, fulfillmentCreateLines); err != nil {
+				return err
+			}
+		}
+		f, err := client.CreateFulfillment([]api.FulfillmentOrderLines{{FulfillmentOrderID: id, Lines: lines}},
+			fulfillmentCreateTracking, fulfillmentCreateNumber, fulfillmentCreateURL, fulfillmentCreateNotify)
 		if err != nil {
 			return err
 		}
@@ -88,6 +117,203 @@ Examples:
 		if len(f.TrackingNumbers) > 0 {
 			fmt.Printf("Tracking: %s\n", strings.Join(f.TrackingNumbers, ", "))
 		}
+		fo, err := client.GetFulfillmentOrder(id)
+		if err != nil {
+			return err
+		}
+		fmt.Println()
+		printFulfillmentOrder(fo)
+		return nil
+	},
+}
+
+// ---- fulfillments get ----
+
+var fulfillmentsGetCmd = &cobra.Command{
+	Use:   "get <fulfillment-id>",
+	Short: "Get a fulfillment with its tracking info and carrier events",
+	Long: `Show a fulfillment: status, tracking, shipped line items, and the carrier's
+tracking events. Fulfillment IDs are shown by 'orders timeline'.
+
+Examples:
+  shopify-admin fulfillments get 1234567890
+  shopify-admin fulfillments get 1234567890 --json`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := resolveID("Fulfillment", args[0])
+		if err != nil {
+			return err
+		}
+		f, err := client.GetFulfillment(id)
+		if err != nil {
+			return err
+		}
+		if output.IsJSON(cmd) {
+			return output.PrintJSON(f, output.IsPretty(cmd))
+		}
+		printFulfillment(f)
+		return nil
+	},
+}
+
+// printFulfillment prints a fulfillment's details, line items, and events.
+func printFulfillment(f *api.Fulfillment) {
+	order, location := "-", "-"
+	if f.Order != nil {
+		order = f.Order.Name
+	}
+	if f.Location != nil {
+		location = f.Location.Name
+	}
+	output.PrintKeyValue([][]string{
+		{"ID", shortID(f.ID)},
+		{"Name", orDash(f.Name)},
+		{"Order", order},
+		{"Status", strings.ToLower(f.Status)},
+		{"Shipment", strings.ToLower(orDash(f.DisplayStatus))},
+		{"Location", location},
+		{"Carrier", orDash(f.TrackingCompany)},
+		{"Tracking", orDash(strings.Join(f.TrackingNumbers, ", "))},
+		{"Tracking URL", orDash(strings.Join(f.TrackingURLs, ", "))},
+		{"Est. delivery", output.FormatDate(f.EstimatedDeliveryAt)},
+		{"Delivered", output.FormatDate(f.DeliveredAt)},
+		{"Created", output.FormatTime(f.CreatedAt)},
+	})
+	if f.FulfillmentLineItems != nil && len(f.FulfillmentLineItems.Edges) > 0 {
+		fmt.Println()
+		rows := make([][]string, len(f.FulfillmentLineItems.Edges))
+		for i, e := range f.FulfillmentLineItems.Edges {
+			li := e.Node
+			rows[i] = []string{
+				fmt.Sprintf("%d", li.Quantity),
+				orDash(li.LineItem.SKU),
+				output.Truncate(li.LineItem.Title, 50),
+			}
+		}
+		output.PrintTable([]string{"QTY", "SKU", "TITLE"}, rows)
+	}
+	if f.Events != nil && len(f.Events.Edges) > 0 {
+		fmt.Println()
+		rows := make([][]string, len(f.Events.Edges))
+		for i, e := range f.Events.Edges {
+			ev := e.Node
+			place := strings.Join(compactStrings(ev.City, ev.Province, ev.Country), ", ")
+			rows[i] = []string{
+				output.FormatTime(ev.HappenedAt),
+				strings.ToLower(ev.Status),
+				orDash(place),
+				output.Truncate(orDash(ev.Message), 50),
+			}
+		}
+		output.PrintTable([]string{"HAPPENED", "EVENT", "PLACE", "MESSAGE"}, rows)
+	}
+}
+
+// compactStrings returns the non-empty values.
+func compactStrings(values ...string) []string {
+	var out []string
+	for _, v := range values {
+		if v != "" {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+// ---- fulfillments update-tracking ----
+
+var (
+	fulfillmentTrackingCompany string
+	fulfillmentTrackingNumber  string
+	fulfillmentTrackingURL     string
+	fulfillmentTrackingNotify  bool
+)
+
+var fulfillmentsUpdateTrackingCmd = &cobra.Command{
+	Use:   "update-tracking <fulfillment-id>",
+	Short: "Correct a fulfillment's tracking company, number, or URL",
+	Long: `Update the tracking info on an existing fulfillment. Flags you don't pass keep
+their current value. With --notify, the customer is emailed the new tracking details.
+
+Examples:
+  shopify-admin fulfillments update-tracking 1234567890 --number 1Z999AA1234567891
+  shopify-admin fulfillments update-tracking 1234567890 --company DHL --number 1234567890 --notify
+  shopify-admin fulfillments update-tracking 1234567890 --url https://track.example.com/1234567890`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		flags := cmd.Flags()
+		if !flags.Changed("company") && !flags.Changed("number") && !flags.Changed("url") {
+			return fmt.Errorf("nothing to update — pass --company, --number, or --url")
+		}
+		id, err := resolveID("Fulfillment", args[0])
+		if err != nil {
+			return err
+		}
+		current, err := client.GetFulfillment(id)
+		if err != nil {
+			return err
+		}
+		company, number, url := current.TrackingCompany, "", ""
+		if len(current.TrackingNumbers) > 0 {
+			number = current.TrackingNumbers[0]
+		}
+		if len(current.TrackingURLs) > 0 {
+			url = current.TrackingURLs[0]
+		}
+		if flags.Changed("company") {
+			company = fulfillmentTrackingCompany
+		}
+		if flags.Changed("number") {
+			number = fulfillmentTrackingNumber
+			if !flags.Changed("url") {
+				url = "" // the old URL points at the old number
+			}
+		}
+		if flags.Changed("url") {
+			url = fulfillmentTrackingURL
+		}
+		f, err := client.UpdateFulfillmentTracking(id, company, number, url, fulfillmentTrackingNotify)
+		if err != nil {
+			return err
+		}
+		if output.IsJSON(cmd) {
+			return output.PrintJSON(f, output.IsPretty(cmd))
+		}
+		fmt.Println("Tracking updated")
+		printFulfillment(f)
+		return nil
+	},
+}
+
+// ---- fulfillments cancel ----
+
+var fulfillmentsCancelCmd = &cobra.Command{
+	Use:   "cancel <fulfillment-id>",
+	Short: "Cancel a fulfillment",
+	Long: `Cancel a fulfillment created by mistake. Its line items go back to their
+fulfillment order and can be fulfilled again.
+
+Examples:
+  shopify-admin fulfillments cancel 1234567890`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := resolveID("Fulfillment", args[0])
+		if err != nil {
+			return err
+		}
+		f, err := client.CancelFulfillment(id)
+		if err != nil {
+			return err
+		}
+		if output.IsJSON(cmd) {
+			return output.PrintJSON(f, output.IsPretty(cmd))
+		}
+		name := shortID(f.ID)
+		if f.Name != "" {
+			name = f.Name
+		}
+		fmt.Printf("Fulfillment %s cancelled.\n", name)
+		fmt.Printf("Status: %s\n", strings.ToLower(f.Status))
 		return nil
 	},
 }
@@ -96,7 +322,20 @@ func init() {
 	fulfillmentsCreateCmd.Flags().StringVar(&fulfillmentCreateTracking, "tracking", "", "Tracking company name")
 	fulfillmentsCreateCmd.Flags().StringVar(&fulfillmentCreateNumber, "number", "", "Tracking number")
 	fulfillmentsCreateCmd.Flags().StringVar(&fulfillmentCreateURL, "url", "", "Tracking URL")
+	fulfillmentsCreateCmd.Flags().StringArrayVar(&fulfillmentCreateLines, "line", nil, "Fulfill only this quantity: <fo-line-id|line-id|sku>:<qty> (repeatable)")
+	fulfillmentsCreateCmd.Flags().BoolVar(&fulfillmentCreateNotify, "notify-customer", false, "Email the customer a shipping confirmation")
+
+	fulfillmentsUpdateTrackingCmd.Flags().StringVar(&fulfillmentTrackingCompany, "company", "", "Tracking company name")
+	fulfillmentsUpdateTrackingCmd.Flags().StringVar(&fulfillmentTrackingNumber, "number", "", "Tracking number")
+	fulfillmentsUpdateTrackingCmd.Flags().StringVar(&fulfillmentTrackingURL, "url", "", "Tracking URL")
+	fulfillmentsUpdateTrackingCmd.Flags().BoolVar(&fulfillmentTrackingNotify, "notify", false, "Email the customer the updated tracking")
 
-	fulfillmentsCmd.AddCommand(fulfillmentsListCmd, fulfillmentsCreateCmd)
+	fulfillmentsCmd.AddCommand(
+		fulfillmentsListCmd,
+		fulfillmentsGetCmd,
+		fulfillmentsCreateCmd,
+		fulfillmentsUpdateTrackingCmd,
+		fulfillmentsCancelCmd,
+	)
 	rootCmd.AddCommand(fulfillmentsCmd)
 }
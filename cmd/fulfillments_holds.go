@@ -0,0 +1,344 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/the20100/shopify-admin-cli/internal/api"
+	"github.com/the20100/shopify-admin-cli/internal/output"
+)
+
+// holdReasons formats fulfillment holds as a comma-separated list of reasons.
+func holdReasons(holds []api.FulfillmentHold) string {
+	if len(holds) == 0 {
+		return "-"
+	}
+	reasons := make([]string, len(holds))
+	for i, h := range holds {
+		reasons[i] = strings.ToLower(h.Reason)
+	}
+	return strings.Join(reasons, ", ")
+}
+
+// fulfillmentOrderLines resolves --line <ref>:<qty> specs against a fulfillment
+// order's line items. Refs are fulfillment order line item IDs, order line item
+// IDs, or SKUs.
+func fulfillmentOrderLines(fo *api.FulfillmentOrder, specs []string) ([]api.FulfillmentOrderLineInput, error) {
+	ids := make([]string, len(fo.LineItems.Edges))
+	skus := make([]string, len(fo.LineItems.Edges))
+	for i, e := range fo.LineItems.Edges {
+		ids[i] = e.Node.LineItem.ID
+		skus[i] = e.Node.LineItem.SKU
+	}
+	name := "fulfillment order " + shortID(fo.ID)
+	lines := make([]api.FulfillmentOrderLineInput, 0, len(specs))
+	for _, spec := range specs {
+		ref, qty, err := splitQuantitySpec(spec)
+		if err != nil {
+			return nil, err
+		}
+		i := fulfillmentOrderLineIndex(fo, ref)
+		if i < 0 {
+			if i, err = matchLineRef(ref, name, ids, skus); err != nil {
+				return nil, err
+			}
+		}
+		li := fo.LineItems.Edges[i].Node
+		if qty == 0 || qty > li.RemainingQuantity {
+			return nil, fmt.Errorf("invalid quantity %d for %s: %d remaining", qty, ref, li.RemainingQuantity)
+		}
+		lines = append(lines, api.FulfillmentOrderLineInput{ID: li.ID, Quantity: qty})
+	}
+	return lines, nil
+}
+
+// fulfillmentOrderLineIndex returns the index of the fulfillment order line item
+// with ID ref, or -1.
+func fulfillmentOrderLineIndex(fo *api.FulfillmentOrder, ref string) int {
+	for i, e := range fo.LineItems.Edges {
+		if e.Node.ID == ref || shortID(e.Node.ID) == ref {
+			return i
+		}
+	}
+	return -1
+}
+
+// printFulfillmentOrder prints a fulfillment order's status, location, holds, and
+// line items.
+func printFulfillmentOrder(fo *api.FulfillmentOrder) {
+	fmt.Printf("ID:         %s\n", shortID(fo.ID))
+	if fo.Order != nil {
+		fmt.Printf("Order:      %s\n", fo.Order.Name)
+	}
+	fmt.Printf("Status:     %s\n", strings.ToLower(fo.Status))
+	fmt.Printf("Location:   %s\n", orDash(fo.AssignedLocation.Name))
+	fmt.Printf("Fulfill at: %s\n", output.FormatTime(fo.FulfillAt))
+	for _, h := range fo.FulfillmentHolds {
+		hold := strings.ToLower(h.Reason)
+		if h.ReasonNotes != "" {
+			hold += " — " + h.ReasonNotes
+		}
+		fmt.Printf("Hold:       %s (%s)\n", hold, shortID(h.ID))
+	}
+	if len(fo.LineItems.Edges) > 0 {
+		fmt.Println()
+		headers := []string{"LINE ID", "SKU", "TITLE", "REMAINING", "TOTAL"}
+		rows := make([][]string, len(fo.LineItems.Edges))
+		for i, e := range fo.LineItems.Edges {
+			li := e.Node
+			rows[i] = []string{
+				shortID(li.LineItem.ID),
+				orDash(li.LineItem.SKU),
+				output.Truncate(li.LineItem.Title, 40),
+				fmt.Sprintf("%d", li.RemainingQuantity),
+				fmt.Sprintf("%d", li.TotalQuantity),
+			}
+		}
+		output.PrintTable(headers, rows)
+	}
+}
+
+// ---- fulfillments hold ----
+
+var (
+	fulfillmentHoldReason string
+	fulfillmentHoldNotes  string
+	fulfillmentHoldLines  []string
+)
+
+var fulfillmentHoldReasons = []string{
+	"AWAITING_PAYMENT", "AWAITING_RETURN_ITEMS", "HIGH_RISK_OF_FRAUD", "INCORRECT_ADDRESS",
+	"INVENTORY_OUT_OF_STOCK", "UNKNOWN_DELIVERY_DATE", "OTHER",
+}
+
+var fulfillmentsHoldCmd = &cobra.Command{
+	Use:   "hold <fulfillment-order-id>",
+	Short: "Put a fulfillment order on hold",
+	Long: `Put a fulfillment order on hold so it can't be fulfilled until the hold is
+released. With --line, only those quantities are held: the fulfillment order keeps
+them and goes on hold, and Shopify moves the rest to a new fulfillment order that
+can still be fulfilled. Both are printed.
+
+Reasons: awaiting_payment, awaiting_return_items, high_risk_of_fraud,
+incorrect_address, inventory_out_of_stock, unknown_delivery_date, other.
+
+Examples:
+  shopify-admin fulfillments hold 1234567890 --reason incorrect_address
+  shopify-admin fulfillments hold 1234567890 --reason inventory_out_of_stock --notes "Restock due Friday"
+  shopify-admin fulfillments hold 1234567890 --reason other --line sku:MUG-01:2`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		reason := strings.ToUpper(fulfillmentHoldReason)
+		valid := false
+		for _, r := range fulfillmentHoldReasons {
+			valid = valid || r == reason
+		}
+		if !valid {
+			return fmt.Errorf("invalid --reason %q: expected one of %s", fulfillmentHoldReason, strings.ToLower(strings.Join(fulfillmentHoldReasons, ", ")))
+		}
+		id, err := resolveID("FulfillmentOrder", args[0])
+		if err != nil {
+			return err
+		}
+		var lines []api.FulfillmentOrderLineInput
+		if len(fulfillmentHoldLines) > 0 {
+			fo, err := client.GetFulfillmentOrder(id)
+			if err != nil {
+				return err
+			}
+			if lines, err = fulfillmentOrderLines(fo, fulfillmentHoldLines); err != nil {
+				return err
+			}
+		}
+		result, err := client.HoldFulfillmentOrder(id, reason, fulfillmentHoldNotes, lines)
+		if err != nil {
+			return err
+		}
+		if output.IsJSON(cmd) {
+			return output.PrintJSON(result, output.IsPretty(cmd))
+		}
+		fmt.Println("Fulfillment order on hold")
+		printFulfillmentOrder(result.FulfillmentOrder)
+		if rest := result.RemainingFulfillmentOrder; rest != nil {
+			fmt.Println()
+			fmt.Println("Remaining items, not on hold")
+			printFulfillmentOrder(rest)
+		}
+		return nil
+	},
+}
+
+// ---- fulfillments release-hold ----
+
+var fulfillmentReleaseHoldIDs []string
+
+var fulfillmentsReleaseHoldCmd = &cobra.Command{
+	Use:   "release-hold <fulfillment-order-id>",
+	Short: "Release holds on a fulfillment order",
+	Long: `Release holds on a fulfillment order so it can be fulfilled. All holds are
+released unless --hold selects specific ones (hold IDs are shown by 'fulfillments hold'
+and 'fulfillments list --json').
+
+Examples:
+  shopify-admin fulfillments release-hold 1234567890
+  shopify-admin fulfillments release-hold 1234567890 --hold 987654321`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := resolveID("FulfillmentOrder", args[0])
+		if err != nil {
+			return err
+		}
+		fo, err := client.ReleaseFulfillmentOrderHold(id, fulfillmentReleaseHoldIDs)
+		if err != nil {
+			return err
+		}
+		if output.IsJSON(cmd) {
+			return output.PrintJSON(fo, output.IsPretty(cmd))
+		}
+		fmt.Println("Hold released")
+		printFulfillmentOrder(fo)
+		return nil
+	},
+}
+
+// ---- fulfillments move ----
+
+var (
+	fulfillmentMoveLocation string
+	fulfillmentMoveLines    []string
+)
+
+var fulfillmentsMoveCmd = &cobra.Command{
+	Use:   "move <fulfillment-order-id>",
+	Short: "Move a fulfillment order to another location",
+	Long: `Move a fulfillment order to another location, e.g. when the assigned warehouse
+runs out of stock. With --line, only those quantities move; the rest stay at the
+original location.
+
+Without --location, lists the locations the fulfillment order can be moved to and
+why the others can't take it.
+
+Examples:
+  shopify-admin fulfillments move 1234567890
+  shopify-admin fulfillments move 1234567890 --location 55555555
+  shopify-admin fulfillments move 1234567890 --location 55555555 --line sku:MUG-01:1`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := resolveID("FulfillmentOrder", args[0])
+		if err != nil {
+			return err
+		}
+		if fulfillmentMoveLocation == "" {
+			if len(fulfillmentMoveLines) > 0 {
+				return fmt.Errorf("--line requires --location")
+			}
+			locations, err := client.ListFulfillmentOrderMoveLocations(id)
+			if err != nil {
+				return err
+			}
+			if output.IsJSON(cmd) {
+				return output.PrintJSON(locations, output.IsPretty(cmd))
+			}
+			if len(locations) == 0 {
+				fmt.Println("No other locations found.")
+				return nil
+			}
+			headers := []string{"LOCATION ID", "NAME", "MOVABLE", "MESSAGE"}
+			rows := make([][]string, len(locations))
+			for i, l := range locations {
+				movable := "no"
+				if l.Movable {
+					movable = "yes"
+				}
+				rows[i] = []string{shortID(l.Location.ID), l.Location.Name, movable, orDash(l.Message)}
+			}
+			output.PrintTable(headers, rows)
+			return nil
+		}
+		locationID, err := resolveID("Location", fulfillmentMoveLocation)
+		if err != nil {
+			return err
+		}
+		var lines []api.FulfillmentOrderLineInput
+		if len(fulfillmentMoveLines) > 0 {
+			fo, err := client.GetFulfillmentOrder(id)
+			if err != nil {
+				return err
+			}
+			if lines, err = fulfillmentOrderLines(fo, fulfillmentMoveLines); err != nil {
+				return err
+			}
+		}
+		fo, err := client.MoveFulfillmentOrder(id, locationID, lines)
+		if err != nil {
+			return err
+		}
+		if output.IsJSON(cmd) {
+			return output.PrintJSON(fo, output.IsPretty(cmd))
+		}
+		fmt.Println("Fulfillment order moved")
+		printFulfillmentOrder(fo)
+		return nil
+	},
+}
+
+// ---- fulfillments reschedule ----
+
+var fulfillmentRescheduleAt string
+
+var fulfillmentsRescheduleCmd = &cobra.Command{
+	Use:   "reschedule <fulfillment-order-id>",
+	Short: "Change when a scheduled fulfillment order is fulfilled",
+	Long: `Change the fulfill-at date of a scheduled fulfillment order, such as a
+pre-order or subscription delivery. Only fulfillment orders with status
+"scheduled" can be rescheduled.
+
+--at takes a date (YYYY-MM-DD, midnight local time) or an RFC 3339 timestamp.
+
+Examples:
+  shopify-admin fulfillments reschedule 1234567890 --at 2026-11-02
+  shopify-admin fulfillments reschedule 1234567890 --at 2026-11-02T09:00:00-05:00`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if fulfillmentRescheduleAt == "" {
+			return fmt.Errorf("--at is required")
+		}
+		at, err := time.Parse(time.RFC3339, fulfillmentRescheduleAt)
+		if err != nil {
+			if at, err = time.ParseInLocation("2006-01-02", fulfillmentRescheduleAt, time.Local); err != nil {
+				return fmt.Errorf("invalid --at %q: expected YYYY-MM-DD or an RFC 3339 timestamp", fulfillmentRescheduleAt)
+			}
+		}
+		id, err := resolveID("FulfillmentOrder", args[0])
+		if err != nil {
+			return err
+		}
+		fo, err := client.RescheduleFulfillmentOrder(id, at.Format(time.RFC3339))
+		if err != nil {
+			return err
+		}
+		if output.IsJSON(cmd) {
+			return output.PrintJSON(fo, output.IsPretty(cmd))
+		}
+		fmt.Println("Fulfillment order rescheduled")
+		printFulfillmentOrder(fo)
+		return nil
+	},
+}
+
+func init() {
+	fulfillmentsHoldCmd.Flags().StringVar(&fulfillmentHoldReason, "reason", "other", "Hold reason")
+	fulfillmentsHoldCmd.Flags().StringVar(&fulfillmentHoldNotes, "notes", "", "Notes explaining the hold")
+	fulfillmentsHoldCmd.Flags().StringArrayVar(&fulfillmentHoldLines, "line", nil, "Hold only this quantity: <line-id|sku>:<qty> (repeatable)")
+
+	fulfillmentsReleaseHoldCmd.Flags().StringArrayVar(&fulfillmentReleaseHoldIDs, "hold", nil, "Release only this hold ID (repeatable)")
+
+	fulfillmentsMoveCmd.Flags().StringVar(&fulfillmentMoveLocation, "location", "", "Destination location ID")
+	fulfillmentsMoveCmd.Flags().StringArrayVar(&fulfillmentMoveLines, "line", nil, "Move only this quantity: <line-id|sku>:<qty> (repeatable)")
+
+	fulfillmentsRescheduleCmd.Flags().StringVar(&fulfillmentRescheduleAt, "at", "", "New fulfill-at date or timestamp (required)")
+
+	fulfillmentsCmd.AddCommand(fulfillmentsHoldCmd, fulfillmentsReleaseHoldCmd, fulfillmentsMoveCmd, fulfillmentsRescheduleCmd)
+}
@@ -0,0 +1,348 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/the20100/shopify-admin-cli/internal/api"
+	"github.com/the20100/shopify-admin-cli/internal/output"
+)
+
+// ---- fulfillments import ----
+
+var (
+	fulfillmentImportDryRun bool
+	fulfillmentImportNotify bool
+)
+
+// manifestRow is one validated row of a carrier manifest.
+type manifestRow struct {
+	row      int
+	order    string
+	sku      string
+	quantity int
+	carrier  string
+	number   string
+	url      string
+}
+
+// manifestLine is a quantity of one SKU allocated to a fulfillment order line item.
+type manifestLine struct {
+	SKU                string `json:"sku"`
+	Quantity           int    `json:"quantity"`
+	FulfillmentOrderID string `json:"fulfillmentOrderId"`
+	lineID             string
+}
+
+// manifestShipment is one fulfillment to create: an order's items under one
+// tracking number, shipped from one location.
+type manifestShipment struct {
+	Order          string         `json:"order"`
+	Carrier        string         `json:"carrier"`
+	TrackingNumber string         `json:"trackingNumber"`
+	TrackingURL    string         `json:"trackingUrl,omitempty"`
+	Location       string         `json:"location"`
+	Lines          []manifestLine `json:"lines"`
+	FulfillmentID  string         `json:"fulfillmentId,omitempty"`
+	Error          string         `json:"error,omitempty"`
+}
+
+// manifestUnmatched is a manifest row that could not be fulfilled.
+type manifestUnmatched struct {
+	Row      int    `json:"row"`
+	Order    string `json:"order"`
+	SKU      string `json:"sku"`
+	Quantity string `json:"quantity"`
+	Reason   string `json:"reason"`
+}
+
+var fulfillmentsImportCmd = &cobra.Command{
+	Use:   "import <manifest.csv>",
+	Short: "Create fulfillments from a carrier or 3PL manifest CSV",
+	Long: `Create fulfillments with tracking from a shipping manifest, one row per shipped SKU.
+
+Required columns: order, sku, quantity, tracking_number
+Optional columns: carrier, tracking_url
+
+Orders are matched by name ("1001" and "#1001" both work). Rows with the same
+order, carrier, and tracking number become one fulfillment. Each SKU is taken
+from the order's open fulfillment orders, as far as their remaining quantities
+allow; items split across locations become one fulfillment per location with the
+same tracking number.
+
+Rows that can't be matched (unknown order or SKU, nothing left to fulfill, bad
+quantity) are reported and skipped; the rest are still fulfilled. --dry-run shows
+what would be fulfilled without creating anything.
+
+Examples:
+  shopify-admin fulfillments import manifest-2026-10-18.csv --dry-run
+  shopify-admin fulfillments import manifest-2026-10-18.csv --notify-customer`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		records, err := readCSVFile(args[0])
+		if err != nil {
+			return err
+		}
+		if err := requireColumns(records, args[0], "order", "sku", "quantity", "tracking_number"); err != nil {
+			return err
+		}
+
+		var unmatched []manifestUnmatched
+		var rows []manifestRow
+		for i, rec := range records {
+			r := manifestRow{
+				row:     i + 2, // the header is row 1
+				order:   rec["order"],
+				sku:     rec["sku"],
+				carrier: rec["carrier"],
+				number:  rec["tracking_number"],
+				url:     rec["tracking_url"],
+			}
+			qty, err := strconv.Atoi(rec["quantity"])
+			reason := ""
+			switch {
+			case r.order == "" && r.sku == "" && rec["quantity"] == "":
+				continue // blank line
+			case r.order == "":
+				reason = "missing order"
+			case r.sku == "":
+				reason = "missing sku"
+			case err != nil || qty <= 0:
+				reason = "invalid quantity"
+			case r.number == "":
+				reason = "missing tracking number"
+			}
+			if reason != "" {
+				unmatched = append(unmatched, manifestUnmatched{Row: r.row, Order: r.order, SKU: r.sku, Quantity: rec["quantity"], Reason: reason})
+				continue
+			}
+			if !strings.HasPrefix(r.order, "#") {
+				r.order = "#" + r.order
+			}
+			r.quantity = qty
+			rows = append(rows, r)
+		}
+
+		shipments, more := planManifest(rows)
+		unmatched = append(unmatched, more...)
+
+		created, units := 0, 0
+		failed := 0
+		if !fulfillmentImportDryRun {
+			for _, s := range shipments {
+				byFO := map[string]*api.FulfillmentOrderLines{}
+				var orders []api.FulfillmentOrderLines
+				var foIDs []string
+				for _, l := range s.Lines {
+					if _, ok := byFO[l.FulfillmentOrderID]; !ok {
+						foIDs = append(foIDs, l.FulfillmentOrderID)
+						byFO[l.FulfillmentOrderID] = &api.FulfillmentOrderLines{FulfillmentOrderID: l.FulfillmentOrderID}
+					}
+					fo := byFO[l.FulfillmentOrderID]
+					fo.Lines = append(fo.Lines, api.FulfillmentOrderLineInput{ID: l.lineID, Quantity: l.Quantity})
+				}
+				for _, id := range foIDs {
+					orders = append(orders, *byFO[id])
+				}
+				f, err := client.CreateFulfillment(orders, s.Carrier, s.TrackingNumber, s.TrackingURL, fulfillmentImportNotify)
+				if err != nil {
+					s.Error = err.Error()
+					failed++
+					if !output.IsJSON(cmd) {
+						fmt.Printf("%s (%s): %s\n", s.Order, s.TrackingNumber, err)
+					}
+					continue
+				}
+				s.FulfillmentID = f.ID
+				created++
+				for _, l := range s.Lines {
+					units += l.Quantity
+				}
+			}
+		}
+
+		if output.IsJSON(cmd) {
+			if shipments == nil {
+				shipments = []*manifestShipment{}
+			}
+			if unmatched == nil {
+				unmatched = []manifestUnmatched{}
+			}
+			if err := output.PrintJSON(map[string]any{"dryRun": fulfillmentImportDryRun, "fulfillments": shipments, "unmatched": unmatched}, output.IsPretty(cmd)); err != nil {
+				return err
+			}
+		} else {
+			if fulfillmentImportDryRun && len(shipments) > 0 {
+				var table [][]string
+				for _, s := range shipments {
+					for _, l := range s.Lines {
+						table = append(table, []string{s.Order, orDash(s.Carrier), s.TrackingNumber, s.Location, shortID(l.FulfillmentOrderID), l.SKU, strconv.Itoa(l.Quantity)})
+					}
+				}
+				output.PrintTable([]string{"ORDER", "CARRIER", "TRACKING", "LOCATION", "FULFILLMENT ORDER", "SKU", "QTY"}, table)
+				fmt.Println()
+			}
+			if len(unmatched) > 0 {
+				fmt.Println("Unmatched rows:")
+				table := make([][]string, len(unmatched))
+				for i, u := range unmatched {
+					table[i] = []string{strconv.Itoa(u.Row), orDash(u.Order), orDash(u.SKU), orDash(u.Quantity), u.Reason}
+				}
+				output.PrintTable([]string{"ROW", "ORDER", "SKU", "QTY", "REASON"}, table)
+				fmt.Println()
+			}
+			if fulfillmentImportDryRun {
+				total := 0
+				for _, s := range shipments {
+					for _, l := range s.Lines {
+						total += l.Quantity
+					}
+				}
+				fmt.Printf("Dry run: would create %d fulfillments (%d units); %d rows unmatched.\n", len(shipments), total, len(unmatched))
+				return nil
+			}
+			fmt.Printf("Created %d fulfillments (%d units); %d rows unmatched.\n", created, units, len(unmatched))
+		}
+		if fulfillmentImportDryRun {
+			return nil
+		}
+		switch {
+		case failed > 0:
+			return fmt.Errorf("%d fulfillments failed", failed)
+		case len(unmatched) > 0:
+			return fmt.Errorf("%d rows unmatched", len(unmatched))
+		}
+		return nil
+	},
+}
+
+// planManifest matches manifest rows to open fulfillment order line items and
+// groups them into shipments. Rows that can't be matched are returned separately.
+func planManifest(rows []manifestRow) ([]*manifestShipment, []manifestUnmatched) {
+	type orderInfo struct {
+		fos []api.FulfillmentOrder
+		err error
+	}
+	orders := map[string]*orderInfo{}
+	remaining := map[string]int{} // fulfillment order line item ID → quantity not yet allocated
+	shipments := map[string]*manifestShipment{}
+	var planned []*manifestShipment
+	var unmatched []manifestUnmatched
+
+	for _, r := range rows {
+		info, ok := orders[strings.ToLower(r.order)]
+		if !ok {
+			info = &orderInfo{}
+			orders[strings.ToLower(r.order)] = info
+			id, err := resolveID("Order", r.order)
+			if err == nil {
+				var conn *api.FulfillmentOrderConnection
+				if conn, err = client.ListFulfillmentOrders(id); err == nil {
+					for _, e := range conn.Edges {
+						fo := e.Node
+						if fo.Status != "OPEN" && fo.Status != "IN_PROGRESS" {
+							continue
+						}
+						info.fos = append(info.fos, fo)
+						for _, le := range fo.LineItems.Edges {
+							remaining[le.Node.ID] = le.Node.RemainingQuantity
+						}
+					}
+				}
+			}
+			info.err = err
+		}
+		fail := func(reason string) {
+			unmatched = append(unmatched, manifestUnmatched{Row: r.row, Order: r.order, SKU: r.sku, Quantity: strconv.Itoa(r.quantity), Reason: reason})
+		}
+		if info.err != nil {
+			fail(info.err.Error())
+			continue
+		}
+
+		// Find the SKU's open quantity across the order's fulfillment orders.
+		type slot struct {
+			fo   *api.FulfillmentOrder
+			line string
+		}
+		var slots []slot
+		available, onOrder := 0, false
+		for i := range info.fos {
+			fo := &info.fos[i]
+			for _, le := range fo.LineItems.Edges {
+				if !strings.EqualFold(le.Node.LineItem.SKU, r.sku) {
+					continue
+				}
+				onOrder = true
+				if remaining[le.Node.ID] > 0 {
+					slots = append(slots, slot{fo, le.Node.ID})
+					available += remaining[le.Node.ID]
+				}
+			}
+		}
+		switch {
+		case len(info.fos) == 0:
+			fail("no open fulfillment orders")
+			continue
+		case !onOrder:
+			fail("SKU not on an open fulfillment order")
+			continue
+		case available < r.quantity:
+			fail(fmt.Sprintf("only %d left to fulfill", available))
+			continue
+		}
+
+		need := r.quantity
+		for _, s := range slots {
+			if need == 0 {
+				break
+			}
+			qty := remaining[s.line]
+			if qty > need {
+				qty = need
+			}
+			remaining[s.line] -= qty
+			need -= qty
+
+			locationID, location := "", s.fo.AssignedLocation.Name
+			if s.fo.AssignedLocation.Location != nil {
+				locationID = s.fo.AssignedLocation.Location.ID
+			}
+			key := strings.Join([]string{strings.ToLower(r.order), r.carrier, r.number, locationID, location}, "\x00")
+			sh, ok := shipments[key]
+			if !ok {
+				sh = &manifestShipment{
+					Order:          r.order,
+					Carrier:        r.carrier,
+					TrackingNumber: r.number,
+					TrackingURL:    r.url,
+					Location:       location,
+				}
+				shipments[key] = sh
+				planned = append(planned, sh)
+			}
+			// Rows repeating an order, SKU, and tracking number add to the same line item.
+			merged := false
+			for i := range sh.Lines {
+				if sh.Lines[i].lineID == s.line {
+					sh.Lines[i].Quantity += qty
+					merged = true
+					break
+				}
+			}
+			if !merged {
+				sh.Lines = append(sh.Lines, manifestLine{SKU: r.sku, Quantity: qty, FulfillmentOrderID: s.fo.ID, lineID: s.line})
+			}
+		}
+	}
+	return planned, unmatched
+}
+
+func init() {
+	fulfillmentsImportCmd.Flags().BoolVar(&fulfillmentImportDryRun, "dry-run", false, "Show what would be fulfilled without creating anything")
+	fulfillmentsImportCmd.Flags().BoolVar(&fulfillmentImportNotify, "notify-customer", false, "Email customers a shipping confirmation")
+
+	fulfillmentsCmd.AddCommand(fulfillmentsImportCmd)
+}
@@ -0,0 +1,414 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/the20100/shopify-admin-cli/internal/api"
+	"github.com/the20100/shopify-admin-cli/internal/output"
+)
+
+var giftCardsCmd = &cobra.Command{
+	Use:   "gift-cards",
+	Short: "Manage Shopify gift cards",
+}
+
+// giftCardStatus summarises enabled/expired/disabled state for display.
+func giftCardStatus(g api.GiftCard) string {
+	switch {
+	case !g.Enabled:
+		return "disabled"
+	case g.ExpiresOn != "" && g.ExpiresOn < time.Now().Format("2006-01-02"):
+		return "expired"
+	}
+	return "enabled"
+}
+
+func giftCardCustomerName(g api.GiftCard) string {
+	if g.Customer == nil {
+		return "-"
+	}
+	name := strings.TrimSpace(g.Customer.FirstName + " " + g.Customer.LastName)
+	if name == "" {
+		return g.Customer.Email
+	}
+	return name
+}
+
+// ---- gift-cards list ----
+
+var (
+	giftCardsListFirst int
+	giftCardsListAfter string
+	giftCardsListQuery string
+)
+
+var giftCardsListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List gift cards",
+	Long: `List gift cards in your Shopify store.
+
+Use --query for Shopify search syntax, e.g.: status:enabled, balance_status:partial, last_characters:a1b2
+
+Examples:
+  shopify-admin gift-cards list
+  shopify-admin gift-cards list --query "status:enabled balance_status:full_or_partial"
+  shopify-admin gift-cards list --first 20 --json`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		conn, err := client.ListGiftCards(giftCardsListFirst, giftCardsListAfter, giftCardsListQuery)
+		if err != nil {
+			return err
+		}
+		if output.IsJSON(cmd) {
+			items := make([]any, len(conn.Edges))
+			for i, e := range conn.Edges {
+				items[i] = e.Node
+			}
+			return output.PrintJSON(items, output.IsPretty(cmd))
+		}
+		if len(conn.Edges) == 0 {
+			fmt.Println("No gift cards found.")
+			return nil
+		}
+		headers := []string{"ID", "CODE", "BALANCE", "INITIAL", "STATUS", "EXPIRES", "CUSTOMER", "CREATED"}
+		rows := make([][]string, len(conn.Edges))
+		for i, e := range conn.Edges {
+			g := e.Node
+			rows[i] = []string{
+				shortID(g.ID),
+				"…" + g.LastCharacters,
+				formatMoney(g.Balance.Amount, g.Balance.CurrencyCode),
+				formatMoney(g.InitialValue.Amount, g.InitialValue.CurrencyCode),
+				giftCardStatus(g),
+				orDash(g.ExpiresOn),
+				output.Truncate(giftCardCustomerName(g), 28),
+				output.FormatTime(g.CreatedAt),
+			}
+		}
+		output.PrintTable(headers, rows)
+		if conn.PageInfo.HasNextPage {
+			fmt.Printf("\n(more results — use --after %s)\n", conn.PageInfo.EndCursor)
+		}
+		return nil
+	},
+}
+
+// ---- gift-cards get ----
+
+var giftCardsGetCmd = &cobra.Command{
+	Use:   "get <id>",
+	Short: "Get details of a specific gift card",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := resolveID("GiftCard", args[0])
+		if err != nil {
+			return err
+		}
+		g, err := client.GetGiftCard(id)
+		if err != nil {
+			return err
+		}
+		if output.IsJSON(cmd) {
+			return output.PrintJSON(g, output.IsPretty(cmd))
+		}
+		order := "-"
+		if g.Order != nil {
+			order = g.Order.Name
+		}
+		customer := giftCardCustomerName(*g)
+		if g.Customer != nil && g.Customer.Email != "" && customer != g.Customer.Email {
+			customer += " <" + g.Customer.Email + ">"
+		}
+		output.PrintKeyValue([][]string{
+			{"ID", shortID(g.ID)},
+			{"Code", g.MaskedCode},
+			{"Status", giftCardStatus(*g)},
+			{"Balance", formatMoney(g.Balance.Amount, g.Balance.CurrencyCode)},
+			{"Initial Value", formatMoney(g.InitialValue.Amount, g.InitialValue.CurrencyCode)},
+			{"Expires", orDash(g.ExpiresOn)},
+			{"Customer", customer},
+			{"Order", order},
+			{"Note", orDash(g.Note)},
+			{"Deactivated", output.FormatTime(g.DeactivatedAt)},
+			{"Created", output.FormatTime(g.CreatedAt)},
+			{"Updated", output.FormatTime(g.UpdatedAt)},
+		})
+		return nil
+	},
+}
+
+// ---- gift-cards create ----
+
+var (
+	giftCardCreateValue    string
+	giftCardCreateCode     string
+	giftCardCreateExpires  string
+	giftCardCreateCustomer string
+	giftCardCreateNote     string
+)
+
+var giftCardsCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Issue a new gift card",
+	Long: `Issue a new gift card in the shop's currency.
+
+The full gift card code is shown only once, in the output of this command.
+Shopify never returns it again — store it or send it to the customer now.
+
+Examples:
+  shopify-admin gift-cards create --value 50
+  shopify-admin gift-cards create --value 25.00 --expires 2025-12-31 --customer jane@example.com
+  shopify-admin gift-cards create --value 100 --code WELCOME-JANE-2024 --note "Support goodwill"`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if giftCardCreateValue == "" {
+			return fmt.Errorf("--value is required")
+		}
+		if err := validateDate("--expires", giftCardCreateExpires); err != nil {
+			return err
+		}
+		customerID := ""
+		if giftCardCreateCustomer != "" {
+			id, err := resolveID("Customer", giftCardCreateCustomer)
+			if err != nil {
+				return err
+			}
+			customerID = id
+		}
+		g, code, err := client.CreateGiftCard(
+			giftCardCreateValue,
+			giftCardCreateCode,
+			giftCardCreateExpires,
+			customerID,
+			giftCardCreateNote,
+		)
+		if err != nil {
+			return err
+		}
+		if output.IsJSON(cmd) {
+			return output.PrintJSON(map[string]any{"giftCard": g, "code": code}, output.IsPretty(cmd))
+		}
+		fmt.Printf("Gift card created: %s\n", formatMoney(g.InitialValue.Amount, g.InitialValue.CurrencyCode))
+		fmt.Printf("ID:      %s\n", shortID(g.ID))
+		fmt.Printf("Code:    %s\n", code)
+		fmt.Printf("Expires: %s\n", orDash(g.ExpiresOn))
+		fmt.Println("\nThis is the only time the full code is shown.")
+		return nil
+	},
+}
+
+// ---- gift-cards update ----
+
+var (
+	giftCardUpdateExpires  string
+	giftCardUpdateCustomer string
+	giftCardUpdateNote     string
+)
+
+var giftCardsUpdateCmd = &cobra.Command{
+	Use:   "update <id>",
+	Short: "Update a gift card's expiry, customer, or note",
+	Long: `Update a gift card. The balance cannot be changed here.
+
+Examples:
+  shopify-admin gift-cards update 12345 --expires 2026-06-30
+  shopify-admin gift-cards update 12345 --customer jane@example.com --note "Reissued"`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if giftCardUpdateExpires == "" && giftCardUpdateCustomer == "" && giftCardUpdateNote == "" {
+			return fmt.Errorf("nothing to update — pass --expires, --customer, or --note")
+		}
+		if err := validateDate("--expires", giftCardUpdateExpires); err != nil {
+			return err
+		}
+		id, err := resolveID("GiftCard", args[0])
+		if err != nil {
+			return err
+		}
+		customerID := ""
+		if giftCardUpdateCustomer != "" {
+			customerID, err = resolveID("Customer", giftCardUpdateCustomer)
+			if err != nil {
+				return err
+			}
+		}
+		g, err := client.UpdateGiftCard(id, giftCardUpdateExpires, customerID, giftCardUpdateNote)
+		if err != nil {
+			return err
+		}
+		if output.IsJSON(cmd) {
+			return output.PrintJSON(g, output.IsPretty(cmd))
+		}
+		fmt.Printf("Gift card updated: %s\n", g.MaskedCode)
+		fmt.Printf("ID:      %s\n", shortID(g.ID))
+		fmt.Printf("Expires: %s\n", orDash(g.ExpiresOn))
+		return nil
+	},
+}
+
+// ---- gift-cards disable ----
+
+var giftCardsDisableCmd = &cobra.Command{
+	Use:   "disable <id>",
+	Short: "Permanently deactivate a gift card (irreversible)",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := resolveID("GiftCard", args[0])
+		if err != nil {
+			return err
+		}
+		g, err := client.DeactivateGiftCard(id)
+		if err != nil {
+			return err
+		}
+		if output.IsJSON(cmd) {
+			return output.PrintJSON(g, output.IsPretty(cmd))
+		}
+		fmt.Printf("Gift card %s disabled (remaining balance %s).\n",
+			args[0], formatMoney(g.Balance.Amount, g.Balance.CurrencyCode))
+		return nil
+	},
+}
+
+// ---- gift-cards balance-report ----
+
+var (
+	giftCardsReportCSV    bool
+	giftCardsReportOutput string
+)
+
+type giftCardBalance struct {
+	Currency       string  `json:"currency"`
+	Cards          int     `json:"cards"`
+	Outstanding    float64 `json:"outstanding"`
+	ExpiredCards   int     `json:"expiredCards"`
+	ExpiredBalance float64 `json:"expiredBalance"`
+	InitialIssued  float64 `json:"initialIssued"`
+}
+
+var giftCardsBalanceReportCmd = &cobra.Command{
+	Use:   "balance-report",
+	Short: "Outstanding gift card liability by currency",
+	Long: `Sum the remaining balance of every enabled gift card, grouped by currency.
+
+Balances on cards past their expiry date are reported separately and are not
+included in the outstanding total. Disabled cards are excluded.
+
+Examples:
+  shopify-admin gift-cards balance-report
+  shopify-admin gift-cards balance-report --csv > gift-card-liability.csv
+  shopify-admin gift-cards balance-report --csv --output liability-2024-06.csv`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		today := time.Now().Format("2006-01-02")
+		byCurrency := map[string]*giftCardBalance{}
+		after := ""
+		for {
+			conn, err := client.ListGiftCards(100, after, "status:enabled balance_status:full_or_partial")
+			if err != nil {
+				return err
+			}
+			for _, e := range conn.Edges {
+				g := e.Node
+				cur := g.Balance.CurrencyCode
+				b, ok := byCurrency[cur]
+				if !ok {
+					b = &giftCardBalance{Currency: cur}
+					byCurrency[cur] = b
+				}
+				if g.ExpiresOn != "" && g.ExpiresOn < today {
+					b.ExpiredCards++
+					b.ExpiredBalance += parseAmount(g.Balance.Amount)
+					continue
+				}
+				b.Cards++
+				b.Outstanding += parseAmount(g.Balance.Amount)
+				b.InitialIssued += parseAmount(g.InitialValue.Amount)
+			}
+			if !conn.PageInfo.HasNextPage {
+				break
+			}
+			after = conn.PageInfo.EndCursor
+		}
+
+		report := make([]giftCardBalance, 0, len(byCurrency))
+		for _, b := range byCurrency {
+			b.Outstanding = round2(b.Outstanding)
+			b.ExpiredBalance = round2(b.ExpiredBalance)
+			b.InitialIssued = round2(b.InitialIssued)
+			report = append(report, *b)
+		}
+		sort.Slice(report, func(i, j int) bool { return report[i].Currency < report[j].Currency })
+
+		headers := []string{"CURRENCY", "CARDS", "OUTSTANDING", "INITIAL VALUE", "EXPIRED CARDS", "EXPIRED BALANCE"}
+		rows := make([][]string, len(report))
+		for i, b := range report {
+			rows[i] = []string{
+				b.Currency,
+				fmt.Sprintf("%d", b.Cards),
+				fmt.Sprintf("%.2f", b.Outstanding),
+				fmt.Sprintf("%.2f", b.InitialIssued),
+				fmt.Sprintf("%d", b.ExpiredCards),
+				fmt.Sprintf("%.2f", b.ExpiredBalance),
+			}
+		}
+
+		if giftCardsReportCSV {
+			var w io.Writer = os.Stdout
+			if giftCardsReportOutput != "" {
+				f, err := os.Create(giftCardsReportOutput)
+				if err != nil {
+					return err
+				}
+				defer f.Close()
+				w = f
+			}
+			csvHeaders := []string{"as_of", "currency", "cards", "outstanding", "initial_value", "expired_cards", "expired_balance"}
+			for i := range rows {
+				rows[i] = append([]string{today}, rows[i]...)
+			}
+			return output.WriteCSV(w, csvHeaders, rows)
+		}
+		if output.IsJSON(cmd) {
+			return output.PrintJSON(report, output.IsPretty(cmd))
+		}
+		if len(report) == 0 {
+			fmt.Println("No gift cards with an outstanding balance found.")
+			return nil
+		}
+		output.PrintTable(headers, rows)
+		return nil
+	},
+}
+
+func init() {
+	giftCardsListCmd.Flags().IntVar(&giftCardsListFirst, "first", 50, "Number of gift cards to return")
+	giftCardsListCmd.Flags().StringVar(&giftCardsListAfter, "after", "", "Pagination cursor")
+	giftCardsListCmd.Flags().StringVar(&giftCardsListQuery, "query", "", "Shopify search query")
+
+	giftCardsCreateCmd.Flags().StringVar(&giftCardCreateValue, "value", "", "Initial value in the shop currency (required)")
+	giftCardsCreateCmd.Flags().StringVar(&giftCardCreateCode, "code", "", "Custom code (8-20 characters; generated if omitted)")
+	giftCardsCreateCmd.Flags().StringVar(&giftCardCreateExpires, "expires", "", "Expiry date (YYYY-MM-DD)")
+	giftCardsCreateCmd.Flags().StringVar(&giftCardCreateCustomer, "customer", "", "Customer ID or email")
+	giftCardsCreateCmd.Flags().StringVar(&giftCardCreateNote, "note", "", "Internal note")
+
+	giftCardsUpdateCmd.Flags().StringVar(&giftCardUpdateExpires, "expires", "", "New expiry date (YYYY-MM-DD)")
+	giftCardsUpdateCmd.Flags().StringVar(&giftCardUpdateCustomer, "customer", "", "New customer ID or email")
+	giftCardsUpdateCmd.Flags().StringVar(&giftCardUpdateNote, "note", "", "New internal note")
+
+	giftCardsBalanceReportCmd.Flags().BoolVar(&giftCardsReportCSV, "csv", false, "Write the report as CSV")
+	giftCardsBalanceReportCmd.Flags().StringVar(&giftCardsReportOutput, "output", "", "Write CSV to this file instead of stdout")
+
+	giftCardsCmd.AddCommand(
+		giftCardsListCmd,
+		giftCardsGetCmd,
+		giftCardsCreateCmd,
+		giftCardsUpdateCmd,
+		giftCardsDisableCmd,
+		giftCardsBalanceReportCmd,
+	)
+	rootCmd.AddCommand(giftCardsCmd)
+}
@@ -1,9 +1,19 @@
 package cmd
 
 import (
+	"bufio"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
 	"strings"
+	"time"
 
+	"github.com/mattn/go-isatty"
+	"github.com/spf13/cobra"
 	"github.com/the20100/shopify-admin-cli/internal/api"
+	"gopkg.in/yaml.v3"
 )
 
 // shortID extracts the numeric portion from a Shopify GID for display.
@@ -11,6 +21,25 @@ func shortID(gid string) string {
 	return api.ShortID(gid)
 }
 
+// resolveID resolves an <id> argument to a GID of resourceType. Besides numeric IDs
+// and GIDs it accepts sku:, barcode:, handle:, #order-name and customer email references.
+func resolveID(resourceType, ref string) (string, error) {
+	return client.Resolve(resourceType, ref)
+}
+
+// resolveIDs resolves each reference with resolveID.
+func resolveIDs(resourceType string, refs []string) ([]string, error) {
+	ids := make([]string, len(refs))
+	for i, ref := range refs {
+		id, err := resolveID(resourceType, ref)
+		if err != nil {
+			return nil, err
+		}
+		ids[i] = id
+	}
+	return ids, nil
+}
+
 // splitTags splits a comma-separated tag string into a slice.
 func splitTags(s string) []string {
 	if s == "" {
@@ -34,3 +63,181 @@ func formatMoney(amount, currency string) string {
 	}
 	return amount + " " + currency
 }
+
+// parseAmount parses a Decimal money amount, returning 0 for empty or invalid values.
+func parseAmount(s string) float64 {
+	f, _ := strconv.ParseFloat(strings.TrimSpace(s), 64)
+	return f
+}
+
+// isNumeric reports whether s is a plain numeric ID.
+func isNumeric(s string) bool {
+	_, err := strconv.ParseUint(s, 10, 64)
+	return err == nil
+}
+
+// orDash returns s, or "-" when s is empty.
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
+
+// yesNo formats a flag as "yes" or "no".
+func yesNo(b bool) string {
+	if b {
+		return "yes"
+	}
+	return "no"
+}
+
+// validateDate checks that a flag value is empty or a YYYY-MM-DD date.
+func validateDate(flag, value string) error {
+	if value == "" {
+		return nil
+	}
+	if _, err := time.Parse("2006-01-02", value); err != nil {
+		return fmt.Errorf("invalid %s %q: expected YYYY-MM-DD", flag, value)
+	}
+	return nil
+}
+
+// parseSince parses a YYYY-MM-DD date or a relative period before now such as
+// "-3d", "-2m", or "-1y".
+func parseSince(flag, value string) (time.Time, error) {
+	if t, err := time.ParseInLocation("2006-01-02", value, time.Local); err == nil {
+		return t, nil
+	}
+	period := strings.TrimPrefix(strings.TrimSpace(value), "-")
+	t, err := subtractPeriod(time.Now(), period)
+	if err != nil || period == "" {
+		return time.Time{}, fmt.Errorf("invalid %s %q: expected YYYY-MM-DD or a relative period like -3d, -2m, -1y", flag, value)
+	}
+	return t, nil
+}
+
+// jsonRequested reports whether --json or --pretty was passed. Commands whose
+// normal output is a file (CSV, HTML, PDF) use it instead of output.IsJSON, which
+// also switches to JSON whenever stdout is redirected.
+func jsonRequested(cmd *cobra.Command) bool {
+	j, _ := cmd.Flags().GetBool("json")
+	p, _ := cmd.Flags().GetBool("pretty")
+	return j || p
+}
+
+// readCSVFile reads a CSV file with a header row and returns one map per record,
+// keyed by lower-cased header name. Cells are trimmed of surrounding whitespace,
+// except in rawColumns, whose values are kept exactly as written.
+func readCSVFile(path string, rawColumns ...string) ([]map[string]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	r := csv.NewReader(f)
+	r.FieldsPerRecord = -1
+	header, err := r.Read()
+	if err != nil {
+		if err == io.EOF {
+			return nil, fmt.Errorf("%s is empty", path)
+		}
+		return nil, fmt.Errorf("reading %s: %w", path, err)
+	}
+	for i, h := range header {
+		header[i] = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(h, "\ufeff")))
+	}
+	raw := map[string]bool{}
+	for _, c := range rawColumns {
+		raw[c] = true
+	}
+	var records []map[string]string
+	for {
+		row, err := r.Read()
+		if err == io.EOF {
+			return records, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("reading %s: %w", path, err)
+		}
+		rec := make(map[string]string, len(header))
+		for i, h := range header {
+			if i >= len(row) {
+				continue
+			}
+			if raw[h] {
+				rec[h] = row[i]
+			} else {
+				rec[h] = strings.TrimSpace(row[i])
+			}
+		}
+		records = append(records, rec)
+	}
+}
+
+// requireColumns returns an error if any of the named columns is missing from the first record.
+func requireColumns(records []map[string]string, path string, cols ...string) error {
+	if len(records) == 0 {
+		return nil
+	}
+	var missing []string
+	for _, c := range cols {
+		if _, ok := records[0][c]; !ok {
+			missing = append(missing, c)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("%s is missing column(s): %s", path, strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// loadSpecFile decodes a YAML or JSON file (JSON is valid YAML) into v.
+func loadSpecFile(path string, v any) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	if err := yaml.Unmarshal(data, v); err != nil {
+		return fmt.Errorf("parsing %s: %w", path, err)
+	}
+	return nil
+}
+
+// confirm asks a yes/no question on stderr and reads the answer from stdin.
+// It refuses to guess when stdin is not a terminal; callers should offer --yes.
+func confirm(question string) (bool, error) {
+	if !isatty.IsTerminal(os.Stdin.Fd()) && !isatty.IsCygwinTerminal(os.Stdin.Fd()) {
+		return false, fmt.Errorf("stdin is not a terminal — pass --yes to confirm")
+	}
+	fmt.Fprintf(os.Stderr, "%s [y/N] ", question)
+	answer, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil && err != io.EOF {
+		return false, fmt.Errorf("reading input: %w", err)
+	}
+	answer = strings.ToLower(strings.TrimSpace(answer))
+	return answer == "y" || answer == "yes", nil
+}
+
+// splitSpec splits a "ref:value" flag argument on its last colon, so refs such as
+// sku:ABC-1 keep their own prefix (sku:ABC-1:2 → "sku:ABC-1", "2").
+func splitSpec(spec string) (ref, value string, err error) {
+	i := strings.LastIndex(spec, ":")
+	if i <= 0 || i == len(spec)-1 {
+		return "", "", fmt.Errorf("invalid %q: expected <ref>:<value>", spec)
+	}
+	return spec[:i], spec[i+1:], nil
+}
+
+// splitQuantitySpec splits a "ref:qty" flag argument and parses the quantity.
+func splitQuantitySpec(spec string) (string, int, error) {
+	ref, value, err := splitSpec(spec)
+	if err != nil {
+		return "", 0, err
+	}
+	qty, err := strconv.Atoi(value)
+	if err != nil || qty < 0 {
+		return "", 0, fmt.Errorf("invalid quantity in %q", spec)
+	}
+	return ref, qty, nil
+}
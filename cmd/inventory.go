@@ -74,7 +74,11 @@ Examples:
 		if inventoryLevelsLocation == "" {
 			return fmt.Errorf("--location is required")
 		}
-		conn, err := client.ListInventoryLevels(inventoryLevelsLocation, inventoryLevelsFirst)
+		locationID, err := resolveID("Location", inventoryLevelsLocation)
+		if err != nil {
+			return err
+		}
+		conn, err := client.ListInventoryLevels(locationID, inventoryLevelsFirst)
 		if err != nil {
 			return err
 		}
@@ -187,7 +191,8 @@ Valid reasons: correction, received, return, damaged, theft, other
 
 Examples:
   shopify-admin inventory adjust --item 12345 --location 67890 --delta 10
-  shopify-admin inventory adjust --item 12345 --location 67890 --delta -5 --reason damaged`,
+  shopify-admin inventory adjust --item 12345 --location 67890 --delta -5 --reason damaged
+  shopify-admin inventory adjust --item sku:MY-SKU-001 --location 67890 --delta 3`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		if inventoryAdjustItem == "" {
 			return fmt.Errorf("--item is required")
@@ -195,9 +200,17 @@ Examples:
 		if inventoryAdjustLocation == "" {
 			return fmt.Errorf("--location is required")
 		}
+		itemID, err := resolveID("InventoryItem", inventoryAdjustItem)
+		if err != nil {
+			return err
+		}
+		locationID, err := resolveID("Location", inventoryAdjustLocation)
+		if err != nil {
+			return err
+		}
 		if err := client.AdjustInventory(
-			inventoryAdjustItem,
-			inventoryAdjustLocation,
+			itemID,
+			locationID,
 			inventoryAdjustDelta,
 			inventoryAdjustReason,
 		); err != nil {
@@ -213,6 +226,82 @@ Examples:
 	},
 }
 
+// ---- inventory set ----
+
+var (
+	inventorySetItem     string
+	inventorySetLocation string
+	inventorySetQuantity int
+	inventorySetName     string
+	inventorySetExpect   int
+	inventorySetReason   string
+)
+
+var inventorySetCmd = &cobra.Command{
+	Use:   "set",
+	Short: "Set the absolute inventory quantity for an item at a location",
+	Long: `Set the available or on-hand quantity of an item at a location to an absolute
+number, e.g. after a stock count. Unlike adjust, running it twice is harmless.
+
+With --expect, the quantity is only set if it is still the expected value; if
+someone changed it in the meantime (a sale, another script) the command fails
+instead of overwriting their change. Without --expect, the current quantity is
+overwritten.
+
+--item takes an inventory item ID, sku:SKU, or a bare SKU.
+
+Examples:
+  shopify-admin inventory set --item sku:MY-SKU-001 --location 67890 --quantity 42
+  shopify-admin inventory set --item MY-SKU-001 --location 67890 --quantity 42 --expect 40
+  shopify-admin inventory set --item 12345 --location 67890 --quantity 100 --name on_hand --reason received`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if inventorySetItem == "" {
+			return fmt.Errorf("--item is required")
+		}
+		if inventorySetLocation == "" {
+			return fmt.Errorf("--location is required")
+		}
+		if !cmd.Flags().Changed("quantity") {
+			return fmt.Errorf("--quantity is required")
+		}
+		if inventorySetName != "available" && inventorySetName != "on_hand" {
+			return fmt.Errorf("invalid --name %q: expected available or on_hand", inventorySetName)
+		}
+		var expect *int
+		if cmd.Flags().Changed("expect") {
+			expect = &inventorySetExpect
+		}
+		item := inventorySetItem
+		if !isNumeric(item) && !strings.Contains(item, ":") {
+			item = "sku:" + item
+		}
+		itemID, err := resolveID("InventoryItem", item)
+		if err != nil {
+			return err
+		}
+		locationID, err := resolveID("Location", inventorySetLocation)
+		if err != nil {
+			return err
+		}
+		change, err := client.SetInventoryQuantity(itemID, locationID, inventorySetName, inventorySetQuantity, expect, inventorySetReason)
+		if err != nil {
+			return err
+		}
+		if output.IsJSON(cmd) {
+			return output.PrintJSON(change, output.IsPretty(cmd))
+		}
+		if change.Delta == 0 {
+			fmt.Printf("Inventory unchanged: %s is already %d for item %s at location %s\n",
+				inventorySetName, change.QuantityAfterChange, inventorySetItem, inventorySetLocation)
+			return nil
+		}
+		fmt.Printf("Inventory set: %s %d → %d (%+d) for item %s at location %s\n",
+			inventorySetName, change.QuantityAfterChange-change.Delta, change.QuantityAfterChange, change.Delta,
+			inventorySetItem, inventorySetLocation)
+		return nil
+	},
+}
+
 func init() {
 	inventoryLocationsCmd.Flags().IntVar(&inventoryLocationsFirst, "first", 50, "Number of locations to return")
 
@@ -223,16 +312,24 @@ func init() {
 	inventoryItemsCmd.Flags().StringVar(&inventoryItemsAfter, "after", "", "Pagination cursor")
 	inventoryItemsCmd.Flags().StringVar(&inventoryItemsQuery, "query", "", "Search query (e.g. sku:MY-SKU)")
 
-	inventoryAdjustCmd.Flags().StringVar(&inventoryAdjustItem, "item", "", "Inventory item ID (required)")
+	inventoryAdjustCmd.Flags().StringVar(&inventoryAdjustItem, "item", "", "Inventory item ID or sku:SKU (required)")
 	inventoryAdjustCmd.Flags().StringVar(&inventoryAdjustLocation, "location", "", "Location ID (required)")
 	inventoryAdjustCmd.Flags().IntVar(&inventoryAdjustDelta, "delta", 0, "Quantity change (positive=add, negative=subtract)")
 	inventoryAdjustCmd.Flags().StringVar(&inventoryAdjustReason, "reason", "correction", "Adjustment reason")
 
+	inventorySetCmd.Flags().StringVar(&inventorySetItem, "item", "", "Inventory item ID, sku:SKU, or SKU (required)")
+	inventorySetCmd.Flags().StringVar(&inventorySetLocation, "location", "", "Location ID (required)")
+	inventorySetCmd.Flags().IntVar(&inventorySetQuantity, "quantity", 0, "New absolute quantity (required)")
+	inventorySetCmd.Flags().StringVar(&inventorySetName, "name", "available", "Quantity to set: available or on_hand")
+	inventorySetCmd.Flags().IntVar(&inventorySetExpect, "expect", 0, "Only set if the current quantity is this value")
+	inventorySetCmd.Flags().StringVar(&inventorySetReason, "reason", "correction", "Adjustment reason")
+
 	inventoryCmd.AddCommand(
 		inventoryLocationsCmd,
 		inventoryLevelsCmd,
 		inventoryItemsCmd,
 		inventoryAdjustCmd,
+		inventorySetCmd,
 	)
 	rootCmd.AddCommand(inventoryCmd)
 }
@@ -63,7 +63,11 @@ var marketsGetCmd = &cobra.Command{
 	Short: "Get details of a specific market",
 	Args:  cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
-		m, err := client.GetMarket(args[0])
+		id, err := resolveID("Market", args[0])
+		if err != nil {
+			return err
+		}
+		m, err := client.GetMarket(id)
 		if err != nil {
 			return err
 		}
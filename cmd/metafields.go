@@ -72,7 +72,11 @@ var metafieldsGetCmd = &cobra.Command{
 	Short: "Get details of a specific metafield",
 	Args:  cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
-		m, err := client.GetMetafield(args[0])
+		id, err := resolveID("Metafield", args[0])
+		if err != nil {
+			return err
+		}
+		m, err := client.GetMetafield(id)
 		if err != nil {
 			return err
 		}
@@ -148,7 +152,11 @@ var metafieldsDeleteCmd = &cobra.Command{
 	Short: "Delete a metafield",
 	Args:  cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
-		if err := client.DeleteMetafield(args[0]); err != nil {
+		id, err := resolveID("Metafield", args[0])
+		if err != nil {
+			return err
+		}
+		if err := client.DeleteMetafield(id); err != nil {
 			return err
 		}
 		fmt.Printf("Metafield %s deleted.\n", args[0])
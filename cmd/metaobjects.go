@@ -112,7 +112,11 @@ var metaobjectsGetCmd = &cobra.Command{
 	Short: "Get details of a specific metaobject",
 	Args:  cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
-		m, err := client.GetMetaobject(args[0])
+		id, err := resolveID("Metaobject", args[0])
+		if err != nil {
+			return err
+		}
+		m, err := client.GetMetaobject(id)
 		if err != nil {
 			return err
 		}
@@ -193,7 +197,11 @@ Examples:
 			return fmt.Errorf("at least one --field key=value is required")
 		}
 		fields := parseFieldPairs(metaobjectUpdateFields)
-		m, err := client.UpdateMetaobject(args[0], fields)
+		id, err := resolveID("Metaobject", args[0])
+		if err != nil {
+			return err
+		}
+		m, err := client.UpdateMetaobject(id, fields)
 		if err != nil {
 			return err
 		}
@@ -213,7 +221,11 @@ var metaobjectsDeleteCmd = &cobra.Command{
 	Short: "Delete a metaobject (irreversible)",
 	Args:  cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
-		if err := client.DeleteMetaobject(args[0]); err != nil {
+		id, err := resolveID("Metaobject", args[0])
+		if err != nil {
+			return err
+		}
+		if err := client.DeleteMetaobject(id); err != nil {
 			return err
 		}
 		fmt.Printf("Metaobject %s deleted.\n", args[0])
@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"github.com/spf13/cobra"
+	"github.com/the20100/shopify-admin-cli/internal/api"
 	"github.com/the20100/shopify-admin-cli/internal/output"
 )
 
@@ -78,7 +79,11 @@ var ordersGetCmd = &cobra.Command{
 	Short: "Get details of a specific order",
 	Args:  cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
-		o, err := client.GetOrder(args[0])
+		id, err := resolveID("Order", args[0])
+		if err != nil {
+			return err
+		}
+		o, err := client.GetOrder(id)
 		if err != nil {
 			return err
 		}
@@ -111,14 +116,25 @@ var ordersGetCmd = &cobra.Command{
 			{"Tags", output.FormatLabels(o.Tags)},
 			{"Created", output.FormatTime(o.CreatedAt)},
 		})
+		if len(o.CustomAttributes) > 0 {
+			fmt.Println()
+			fmt.Println("Attributes:")
+			rows := make([][]string, len(o.CustomAttributes))
+			for i, a := range o.CustomAttributes {
+				rows[i] = []string{a.Key, a.Value}
+			}
+			output.PrintKeyValue(rows)
+		}
+		printOrderRisk(o.Risk)
 		if len(o.LineItems.Edges) > 0 {
 			fmt.Println()
 			fmt.Println("Line items:")
-			headers := []string{"TITLE", "QTY", "PRICE", "SKU"}
+			headers := []string{"LINE", "TITLE", "QTY", "PRICE", "SKU"}
 			rows := make([][]string, len(o.LineItems.Edges))
 			for i, e := range o.LineItems.Edges {
 				li := e.Node
 				rows[i] = []string{
+					shortID(li.ID),
 					output.Truncate(li.Title, 40),
 					fmt.Sprintf("%d", li.Quantity),
 					formatMoney(li.OriginalUnitPriceSet.ShopMoney.Amount, li.OriginalUnitPriceSet.ShopMoney.CurrencyCode),
@@ -136,7 +152,11 @@ var ordersCloseCmd = &cobra.Command{
 	Short: "Mark an order as closed",
 	Args:  cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
-		o, err := client.CloseOrder(args[0])
+		id, err := resolveID("Order", args[0])
+		if err != nil {
+			return err
+		}
+		o, err := client.CloseOrder(id)
 		if err != nil {
 			return err
 		}
@@ -164,7 +184,11 @@ Examples:
   shopify-admin orders cancel 1234567890 --reason customer --refund --restock`,
 	Args: cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
-		if err := client.CancelOrder(args[0], strings.ToUpper(orderCancelReason), orderCancelRefund, orderCancelRestock); err != nil {
+		id, err := resolveID("Order", args[0])
+		if err != nil {
+			return err
+		}
+		if err := client.CancelOrder(id, strings.ToUpper(orderCancelReason), orderCancelRefund, orderCancelRestock); err != nil {
 			return err
 		}
 		fmt.Printf("Order %s cancelled.\n", args[0])
@@ -177,7 +201,11 @@ var ordersMarkPaidCmd = &cobra.Command{
 	Short: "Mark an order as paid",
 	Args:  cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
-		o, err := client.MarkOrderAsPaid(args[0])
+		id, err := resolveID("Order", args[0])
+		if err != nil {
+			return err
+		}
+		o, err := client.MarkOrderAsPaid(id)
 		if err != nil {
 			return err
 		}
@@ -190,6 +218,107 @@ var ordersMarkPaidCmd = &cobra.Command{
 	},
 }
 
+var (
+	orderUpdateNote                string
+	orderUpdateEmail               string
+	orderUpdateTags                string
+	orderUpdateShippingAddressFile string
+	orderUpdateAttributes          []string
+)
+
+var ordersUpdateCmd = &cobra.Command{
+	Use:   "update <id>",
+	Short: "Update an order's note, email, tags, shipping address, or attributes",
+	Long: `Update an order.
+
+--tags replaces all tags (use 'tags add/remove' to change individual tags).
+--attribute sets a custom attribute and keeps the others; an empty value removes it.
+--shipping-address-file takes a MailingAddressInput as YAML or JSON and replaces
+the whole shipping address.
+
+Examples:
+  shopify-admin orders update "#1001" --note "Customer called: leave at back door"
+  shopify-admin orders update 1234567890 --email new@example.com
+  shopify-admin orders update "#1001" --shipping-address-file corrected-address.yaml
+  shopify-admin orders update "#1001" --attribute gift_message="Happy birthday" --attribute po_number=`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := resolveID("Order", args[0])
+		if err != nil {
+			return err
+		}
+		input := map[string]any{}
+		if cmd.Flags().Changed("note") {
+			input["note"] = orderUpdateNote
+		}
+		if orderUpdateEmail != "" {
+			input["email"] = orderUpdateEmail
+		}
+		if cmd.Flags().Changed("tags") {
+			input["tags"] = splitTags(orderUpdateTags)
+		}
+		if orderUpdateShippingAddressFile != "" {
+			var addr map[string]any
+			if err := loadSpecFile(orderUpdateShippingAddressFile, &addr); err != nil {
+				return err
+			}
+			input["shippingAddress"] = addr
+		}
+		if len(orderUpdateAttributes) > 0 {
+			current, err := client.GetOrder(id)
+			if err != nil {
+				return err
+			}
+			attrs, err := mergeAttributes(current.CustomAttributes, orderUpdateAttributes)
+			if err != nil {
+				return err
+			}
+			input["customAttributes"] = attrs
+		}
+		if len(input) == 0 {
+			return fmt.Errorf("nothing to update — pass --note, --email, --tags, --shipping-address-file, or --attribute")
+		}
+		o, err := client.UpdateOrder(id, input)
+		if err != nil {
+			return err
+		}
+		if output.IsJSON(cmd) {
+			return output.PrintJSON(o, output.IsPretty(cmd))
+		}
+		fmt.Printf("Order %s updated.\n", o.Name)
+		return nil
+	},
+}
+
+// mergeAttributes applies key=value specs to existing attributes, keeping their order.
+// An empty value removes the attribute.
+func mergeAttributes(current []api.Attribute, specs []string) ([]api.Attribute, error) {
+	values := make(map[string]string, len(current))
+	keys := make([]string, 0, len(current))
+	for _, a := range current {
+		values[a.Key] = a.Value
+		keys = append(keys, a.Key)
+	}
+	for _, spec := range specs {
+		key, value, ok := strings.Cut(spec, "=")
+		key = strings.TrimSpace(key)
+		if !ok || key == "" {
+			return nil, fmt.Errorf("invalid --attribute %q: expected key=value", spec)
+		}
+		if _, exists := values[key]; !exists {
+			keys = append(keys, key)
+		}
+		values[key] = value
+	}
+	attrs := make([]api.Attribute, 0, len(keys))
+	for _, k := range keys {
+		if values[k] != "" {
+			attrs = append(attrs, api.Attribute{Key: k, Value: values[k]})
+		}
+	}
+	return attrs, nil
+}
+
 func init() {
 	ordersListCmd.Flags().IntVar(&ordersListFirst, "first", 50, "Number of orders to return")
 	ordersListCmd.Flags().StringVar(&ordersListAfter, "after", "", "Pagination cursor")
@@ -199,9 +328,16 @@ func init() {
 	ordersCancelCmd.Flags().BoolVar(&orderCancelRefund, "refund", false, "Refund the order when cancelling")
 	ordersCancelCmd.Flags().BoolVar(&orderCancelRestock, "restock", false, "Restock items when cancelling")
 
+	ordersUpdateCmd.Flags().StringVar(&orderUpdateNote, "note", "", "New order note (empty string clears it)")
+	ordersUpdateCmd.Flags().StringVar(&orderUpdateEmail, "email", "", "New customer email for the order")
+	ordersUpdateCmd.Flags().StringVar(&orderUpdateTags, "tags", "", "New comma-separated tags (replaces existing)")
+	ordersUpdateCmd.Flags().StringVar(&orderUpdateShippingAddressFile, "shipping-address-file", "", "Shipping address as YAML or JSON")
+	ordersUpdateCmd.Flags().StringArrayVar(&orderUpdateAttributes, "attribute", nil, "Set a custom attribute: key=value (repeatable; empty value removes)")
+
 	ordersCmd.AddCommand(
 		ordersListCmd,
 		ordersGetCmd,
+		ordersUpdateCmd,
 		ordersCloseCmd,
 		ordersCancelCmd,
 		ordersMarkPaidCmd,
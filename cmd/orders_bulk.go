@@ -0,0 +1,455 @@
+package cmd
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/the20100/shopify-admin-cli/internal/api"
+	"github.com/the20100/shopify-admin-cli/internal/output"
+)
+
+// ---- orders bulk ----
+
+var (
+	orderBulkQuery         string
+	orderBulkConcurrency   int
+	orderBulkDryRun        bool
+	orderBulkYes           bool
+	orderBulkState         string
+	orderBulkRestart       bool
+	orderBulkCancelReason  string
+	orderBulkCancelRefund  bool
+	orderBulkCancelRestock bool
+)
+
+// bulkAction applies one change to one order. value is the action's argument
+// (tags or note text) when it takes one.
+type bulkAction struct {
+	arg string // name of the required argument, or "" for none
+	run func(o api.Order, value string) error
+}
+
+var orderBulkActions = map[string]bulkAction{
+	"close": {run: func(o api.Order, _ string) error {
+		_, err := client.CloseOrder(o.ID)
+		return err
+	}},
+	"mark-paid": {run: func(o api.Order, _ string) error {
+		_, err := client.MarkOrderAsPaid(o.ID)
+		return err
+	}},
+	"cancel": {run: func(o api.Order, _ string) error {
+		return client.CancelOrder(o.ID, strings.ToUpper(orderBulkCancelReason), orderBulkCancelRefund, orderBulkCancelRestock)
+	}},
+	"add-tag": {arg: "tags", run: func(o api.Order, value string) error {
+		return client.AddTags(orderTagResource(), o.ID, splitTags(value))
+	}},
+	"remove-tag": {arg: "tags", run: func(o api.Order, value string) error {
+		return client.RemoveTags(orderTagResource(), o.ID, splitTags(value))
+	}},
+	"add-note": {arg: "text", run: func(o api.Order, value string) error {
+		note := value
+		if strings.TrimSpace(o.Note) != "" {
+			note = strings.TrimRight(o.Note, "\n") + "\n" + value
+		}
+		_, err := client.UpdateOrder(o.ID, map[string]any{"note": note})
+		return err
+	}},
+}
+
+func orderTagResource() api.TaggableResource {
+	r, _ := api.LookupTaggableResource("orders")
+	return r
+}
+
+// bulkState records which orders a bulk run has processed, so an interrupted or
+// partly failed run can be resumed by running the same command again.
+type bulkState struct {
+	Action  string            `json:"action"`
+	Value   string            `json:"value,omitempty"`
+	Query   string            `json:"query"`
+	Started string            `json:"started"`
+	Done    map[string]string `json:"done"`   // order ID → name
+	Failed  map[string]string `json:"failed"` // order ID → error
+
+	mu   sync.Mutex
+	path string
+}
+
+// bulkStatePath returns the default state file for a bulk run: one file per
+// action, value, and query under the user cache directory.
+func bulkStatePath(action, value, query string) (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256([]byte(action + "\x00" + value + "\x00" + query))
+	return filepath.Join(dir, "shopify-admin", "bulk", action+"-"+hex.EncodeToString(sum[:6])+".json"), nil
+}
+
+func newBulkState(path, action, value, query string) *bulkState {
+	return &bulkState{
+		Action:  action,
+		Value:   value,
+		Query:   query,
+		Started: time.Now().Format(time.RFC3339),
+		Done:    map[string]string{},
+		Failed:  map[string]string{},
+		path:    path,
+	}
+}
+
+// load reads saved progress from the state file, if there is one. Failures from
+// the previous run are dropped so they are retried.
+func (s *bulkState) load() error {
+	data, err := os.ReadFile(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	var saved bulkState
+	if err := json.Unmarshal(data, &saved); err != nil {
+		return fmt.Errorf("parsing %s: %w", s.path, err)
+	}
+	if saved.Action != s.Action || saved.Value != s.Value || saved.Query != s.Query {
+		return fmt.Errorf("%s belongs to a different bulk run (%s %q on %q) — pass --restart to overwrite it", s.path, saved.Action, saved.Value, saved.Query)
+	}
+	s.Started = saved.Started
+	for id, name := range saved.Done {
+		s.Done[id] = name
+	}
+	return nil
+}
+
+// record stores the outcome for one order and saves the state file.
+func (s *bulkState) record(o api.Order, err error) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err != nil {
+		s.Failed[o.ID] = err.Error()
+	} else {
+		s.Done[o.ID] = o.Name
+	}
+	return s.save()
+}
+
+func (s *bulkState) save() error {
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(s.path), 0o700); err != nil {
+		return err
+	}
+	return os.WriteFile(s.path, data, 0o600)
+}
+
+var ordersBulkCmd = &cobra.Command{
+	Use:   "bulk <action> [tags|text] --query <search>",
+	Short: "Apply an action to every order matching a search query",
+	Long: `Apply an action to every order matching --query, several orders at a time.
+
+Actions:
+  close                 close the orders
+  mark-paid             mark the orders as paid
+  cancel                cancel the orders (--reason, --refund, --restock)
+  add-tag <tags>        add comma-separated tags
+  remove-tag <tags>     remove comma-separated tags
+  add-note <text>       append a line to the order note
+
+--dry-run lists the affected orders without changing anything. Otherwise you are
+asked to confirm (skip with --yes).
+
+Requests run --concurrency at a time (default 4). Requests that Shopify throttles
+wait for the rate limit to recover and are retried.
+
+Progress is saved after every order. If a run is interrupted (Ctrl-C) or some
+orders fail, run the same command again to resume: orders already done are
+skipped and failed ones are retried. Pass --restart to start over, or --state to
+choose the state file.
+
+Examples:
+  shopify-admin orders bulk close --query "fulfillment_status:shipped financial_status:paid" --dry-run
+  shopify-admin orders bulk add-tag "wholesale,net30" --query "email:*@acme.com"
+  shopify-admin orders bulk cancel --query "tag:fraud-confirmed" --reason fraud --restock --yes
+  shopify-admin orders bulk add-note "Delayed by carrier strike" --query "created_at:>=2026-10-01 fulfillment_status:unfulfilled"`,
+	Args: cobra.RangeArgs(1, 2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		name := strings.ToLower(args[0])
+		action, ok := orderBulkActions[name]
+		if !ok {
+			names := make([]string, 0, len(orderBulkActions))
+			for n := range orderBulkActions {
+				names = append(names, n)
+			}
+			sort.Strings(names)
+			return fmt.Errorf("unknown action %q — available: %s", args[0], strings.Join(names, ", "))
+		}
+		value := ""
+		switch {
+		case action.arg != "" && len(args) != 2:
+			return fmt.Errorf("%s requires <%s>", name, action.arg)
+		case action.arg == "" && len(args) != 1:
+			return fmt.Errorf("%s takes no argument", name)
+		case action.arg != "":
+			value = args[1]
+		}
+		if action.arg == "tags" && len(splitTags(value)) == 0 {
+			return fmt.Errorf("no tags given")
+		}
+		if strings.TrimSpace(orderBulkQuery) == "" {
+			return fmt.Errorf("--query is required")
+		}
+		if orderBulkConcurrency < 1 || orderBulkConcurrency > 10 {
+			return fmt.Errorf("--concurrency must be between 1 and 10")
+		}
+		if name == "cancel" {
+			switch strings.ToLower(orderBulkCancelReason) {
+			case "customer", "fraud", "inventory", "declined", "other":
+			default:
+				return fmt.Errorf("invalid --reason %q: expected customer, fraud, inventory, declined, or other", orderBulkCancelReason)
+			}
+		}
+
+		path := orderBulkState
+		if path == "" {
+			var err error
+			if path, err = bulkStatePath(name, value, orderBulkQuery); err != nil {
+				return err
+			}
+		}
+		state := newBulkState(path, name, value, orderBulkQuery)
+		if !orderBulkRestart {
+			if err := state.load(); err != nil {
+				return err
+			}
+		}
+
+		orders, err := collectOrders(orderBulkQuery)
+		if err != nil {
+			return err
+		}
+		var pending []api.Order
+		for _, o := range orders {
+			if _, done := state.Done[o.ID]; !done {
+				pending = append(pending, o)
+			}
+		}
+		skipped := len(orders) - len(pending)
+
+		if orderBulkDryRun {
+			return printBulkPreview(cmd, name, value, pending, skipped)
+		}
+		if len(pending) == 0 {
+			fmt.Printf("No orders to %s (%d matching, %d already done).\n", name, len(orders), skipped)
+			return nil
+		}
+		if !orderBulkYes {
+			question := fmt.Sprintf("Apply %s to %d orders?", name, len(pending))
+			if skipped > 0 {
+				question = fmt.Sprintf("Resume %s on %d remaining orders (%d already done)?", name, len(pending), skipped)
+			}
+			ok, err := confirm(question)
+			if err != nil {
+				return err
+			}
+			if !ok {
+				return fmt.Errorf("aborted")
+			}
+		}
+
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+		defer stop()
+		quiet := output.IsJSON(cmd)
+		jobs := make(chan api.Order)
+		var wg sync.WaitGroup
+		var mu sync.Mutex
+		var saveErr error
+		finished := 0
+		for w := 0; w < orderBulkConcurrency; w++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for o := range jobs {
+					runErr := action.run(o, value)
+					err := state.record(o, runErr)
+					mu.Lock()
+					finished++
+					if err != nil && saveErr == nil {
+						saveErr = err
+					}
+					if !quiet {
+						status := "ok"
+						if runErr != nil {
+							status = "failed: " + runErr.Error()
+						}
+						fmt.Fprintf(os.Stderr, "[%d/%d] %s %s\n", finished, len(pending), o.Name, status)
+					}
+					mu.Unlock()
+				}
+			}()
+		}
+	dispatch:
+		for _, o := range pending {
+			select {
+			case <-ctx.Done():
+				break dispatch
+			case jobs <- o:
+			}
+		}
+		close(jobs)
+		wg.Wait()
+		interrupted := ctx.Err() != nil
+		if saveErr != nil {
+			return fmt.Errorf("saving progress to %s: %w", state.path, saveErr)
+		}
+
+		succeeded := 0
+		for _, o := range pending {
+			if _, ok := state.Done[o.ID]; ok {
+				succeeded++
+			}
+		}
+		remaining := len(pending) - succeeded - len(state.Failed)
+		complete := !interrupted && len(state.Failed) == 0
+		if complete {
+			if err := os.Remove(state.path); err != nil && !errors.Is(err, os.ErrNotExist) {
+				return err
+			}
+		}
+
+		if output.IsJSON(cmd) {
+			failed := make([]map[string]string, 0, len(state.Failed))
+			for _, o := range pending {
+				if msg, ok := state.Failed[o.ID]; ok {
+					failed = append(failed, map[string]string{"id": o.ID, "name": o.Name, "error": msg})
+				}
+			}
+			result := map[string]any{
+				"action":      name,
+				"succeeded":   succeeded,
+				"failed":      failed,
+				"skipped":     skipped,
+				"remaining":   remaining,
+				"interrupted": interrupted,
+			}
+			if !complete {
+				result["state"] = state.path
+			}
+			if err := output.PrintJSON(result, output.IsPretty(cmd)); err != nil {
+				return err
+			}
+		} else {
+			fmt.Printf("\nSucceeded: %d  Failed: %d  Skipped (already done): %d", succeeded, len(state.Failed), skipped)
+			if remaining > 0 {
+				fmt.Printf("  Not started: %d", remaining)
+			}
+			fmt.Println()
+			if len(state.Failed) > 0 {
+				fmt.Println()
+				var rows [][]string
+				for _, o := range pending {
+					if msg, ok := state.Failed[o.ID]; ok {
+						rows = append(rows, []string{o.Name, shortID(o.ID), output.Truncate(msg, 70)})
+					}
+				}
+				output.PrintTable([]string{"ORDER", "ID", "ERROR"}, rows)
+			}
+			if !complete {
+				fmt.Printf("\nProgress saved to %s — run the same command again to resume.\n", state.path)
+			}
+		}
+		switch {
+		case interrupted:
+			return fmt.Errorf("interrupted")
+		case len(state.Failed) > 0:
+			return fmt.Errorf("%d of %d orders failed", len(state.Failed), len(pending))
+		}
+		return nil
+	},
+}
+
+// collectOrders pages through every order matching query.
+func collectOrders(query string) ([]api.Order, error) {
+	var orders []api.Order
+	after := ""
+	for {
+		conn, err := client.ListOrders(100, after, query)
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range conn.Edges {
+			orders = append(orders, e.Node)
+		}
+		if !conn.PageInfo.HasNextPage {
+			return orders, nil
+		}
+		after = conn.PageInfo.EndCursor
+	}
+}
+
+// printBulkPreview lists the orders a bulk action would change.
+func printBulkPreview(cmd *cobra.Command, action, value string, orders []api.Order, skipped int) error {
+	if output.IsJSON(cmd) {
+		return output.PrintJSON(map[string]any{"action": action, "value": value, "orders": orders, "skipped": skipped}, output.IsPretty(cmd))
+	}
+	if len(orders) == 0 {
+		fmt.Println("No orders found.")
+		return nil
+	}
+	headers := []string{"ID", "NAME", "FINANCIAL", "FULFILLMENT", "TOTAL", "CUSTOMER", "CREATED"}
+	rows := make([][]string, len(orders))
+	for i, o := range orders {
+		customer := "-"
+		if o.Customer != nil {
+			customer = strings.TrimSpace(o.Customer.FirstName + " " + o.Customer.LastName)
+		}
+		rows[i] = []string{
+			shortID(o.ID),
+			o.Name,
+			strings.ToLower(o.FinancialStatus),
+			strings.ToLower(o.DisplayFulfillmentStatus),
+			formatMoney(o.TotalPriceSet.ShopMoney.Amount, o.TotalPriceSet.ShopMoney.CurrencyCode),
+			output.Truncate(customer, 24),
+			output.FormatTime(o.CreatedAt),
+		}
+	}
+	output.PrintTable(headers, rows)
+	summary := fmt.Sprintf("\nDry run — would %s %d orders", action, len(orders))
+	if value != "" {
+		summary += fmt.Sprintf(" (%s)", value)
+	}
+	if skipped > 0 {
+		summary += fmt.Sprintf("; %d already done in a previous run", skipped)
+	}
+	fmt.Println(summary + ".")
+	return nil
+}
+
+func init() {
+	ordersBulkCmd.Flags().StringVar(&orderBulkQuery, "query", "", "Shopify order search query selecting the orders (required)")
+	ordersBulkCmd.Flags().IntVar(&orderBulkConcurrency, "concurrency", 4, "Orders processed at a time (1-10)")
+	ordersBulkCmd.Flags().BoolVar(&orderBulkDryRun, "dry-run", false, "List the affected orders without changing them")
+	ordersBulkCmd.Flags().BoolVar(&orderBulkYes, "yes", false, "Apply without asking for confirmation")
+	ordersBulkCmd.Flags().StringVar(&orderBulkState, "state", "", "Progress file for resuming (default: in the user cache directory)")
+	ordersBulkCmd.Flags().BoolVar(&orderBulkRestart, "restart", false, "Ignore saved progress and process every matching order")
+	ordersBulkCmd.Flags().StringVar(&orderBulkCancelReason, "reason", "other", "cancel: reason (customer, fraud, inventory, declined, other)")
+	ordersBulkCmd.Flags().BoolVar(&orderBulkCancelRefund, "refund", false, "cancel: refund the orders")
+	ordersBulkCmd.Flags().BoolVar(&orderBulkCancelRestock, "restock", false, "cancel: restock items")
+
+	ordersCmd.AddCommand(ordersBulkCmd)
+}
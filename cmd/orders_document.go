@@ -0,0 +1,125 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/mattn/go-isatty"
+	"github.com/spf13/cobra"
+	"github.com/the20100/shopify-admin-cli/internal/api"
+	"github.com/the20100/shopify-admin-cli/internal/document"
+	"github.com/the20100/shopify-admin-cli/internal/output"
+)
+
+// ---- orders document ----
+
+var (
+	orderDocumentType     string
+	orderDocumentFormat   string
+	orderDocumentTemplate string
+	orderDocumentOutput   string
+)
+
+var ordersDocumentCmd = &cobra.Command{
+	Use:   "document <id>...",
+	Short: "Render packing slips or invoices as HTML or PDF",
+	Long: `Render a printable packing slip or invoice for one or more orders. All orders go
+into one file, one document per page, ready to print. Rendering happens locally;
+no external service is used.
+
+  --type packing-slip|invoice
+  --format html|pdf
+  --template <file>   custom Go html/template (HTML only)
+
+HTML documents show product images (loaded from Shopify's CDN when printed). PDF
+documents use a built-in text layout without images.
+
+Custom templates receive:
+  .Type, .Title, .GeneratedAt
+  .Shop     name, email, billingAddress (see 'shop --json')
+  .Orders   orders as returned by 'orders get --json'
+and the functions money, date, address, shopAddress, itemTitle, hasAmount,
+totalQuantity, upper, lower, add.
+
+Examples:
+  shopify-admin orders document "#1001" "#1002" "#1003" --output slips.html
+  shopify-admin orders document "#1001" --type invoice --format pdf --output invoice-1001.pdf
+  shopify-admin orders document 1234567890 --template my-slip.tmpl > slip.html`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if orderDocumentType != document.PackingSlip && orderDocumentType != document.Invoice {
+			return fmt.Errorf("invalid --type %q: expected packing-slip or invoice", orderDocumentType)
+		}
+		if orderDocumentFormat != "html" && orderDocumentFormat != "pdf" {
+			return fmt.Errorf("invalid --format %q: expected html or pdf", orderDocumentFormat)
+		}
+		tmpl := ""
+		if orderDocumentTemplate != "" {
+			if orderDocumentFormat != "html" {
+				return fmt.Errorf("--template only applies to --format html")
+			}
+			b, err := os.ReadFile(orderDocumentTemplate)
+			if err != nil {
+				return err
+			}
+			tmpl = string(b)
+		}
+		if orderDocumentFormat == "pdf" && orderDocumentOutput == "" && isatty.IsTerminal(os.Stdout.Fd()) {
+			return fmt.Errorf("refusing to write PDF to a terminal — pass --output or redirect stdout")
+		}
+
+		ids, err := resolveIDs("Order", args)
+		if err != nil {
+			return err
+		}
+		shop, err := client.GetShop()
+		if err != nil {
+			return err
+		}
+		orders := make([]*api.Order, len(ids))
+		for i, id := range ids {
+			if orders[i], err = client.GetOrder(id); err != nil {
+				return err
+			}
+		}
+		if jsonRequested(cmd) {
+			return output.PrintJSON(map[string]any{"shop": shop, "orders": orders}, output.IsPretty(cmd))
+		}
+		data, err := document.NewData(orderDocumentType, shop, orders)
+		if err != nil {
+			return err
+		}
+
+		var w io.Writer = os.Stdout
+		if orderDocumentOutput != "" {
+			f, err := os.Create(orderDocumentOutput)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			w = f
+		}
+		if orderDocumentFormat == "pdf" {
+			err = document.RenderPDF(w, data)
+		} else {
+			err = document.RenderHTML(w, data, tmpl)
+		}
+		if err != nil {
+			return err
+		}
+		if orderDocumentOutput != "" {
+			fmt.Fprintf(os.Stderr, "Wrote %d %s document(s) to %s.\n", len(orders), orderDocumentType, orderDocumentOutput)
+		}
+		return nil
+	},
+}
+
+func init() {
+	ordersDocumentCmd.Flags().StringVar(&orderDocumentType, "type", document.PackingSlip, "Document type: packing-slip or invoice")
+	ordersDocumentCmd.Flags().StringVar(&orderDocumentFormat, "format", "html", "Output format: html or pdf")
+	ordersDocumentCmd.Flags().StringVar(&orderDocumentTemplate, "template", "", "Custom html/template file")
+	ordersDocumentCmd.Flags().StringVar(&orderDocumentOutput, "output", "", "Write to this file instead of stdout")
+
+	ordersCmd.AddCommand(ordersDocumentCmd)
+}
@@ -0,0 +1,308 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/the20100/shopify-admin-cli/internal/api"
+	"github.com/the20100/shopify-admin-cli/internal/output"
+)
+
+// ---- orders edit ----
+
+var (
+	orderEditAddVariants []string
+	orderEditSetQtys     []string
+	orderEditDiscounts   []string
+	orderEditRestock     bool
+	orderEditNotify      bool
+	orderEditStaffNote   string
+	orderEditDryRun      bool
+	orderEditYes         bool
+)
+
+var ordersEditCmd = &cobra.Command{
+	Use:   "edit <id>",
+	Short: "Add items, change quantities, or discount lines on an existing order",
+	Long: `Edit an order after checkout. Changes are staged, the recalculated order is shown,
+and nothing is applied until you confirm (or pass --yes).
+
+Lines are referenced by line item ID (see 'orders get') or by SKU.
+Changes are applied in order: --add-variant, then --set-qty, then --discount,
+so a discount can target a line added in the same edit.
+
+  --add-variant <variant>:<qty>   variant ID, sku:... or barcode:...
+  --set-qty <line>:<qty>          0 removes the line
+  --discount <line>:<10%|5.00>    percentage or fixed amount per unit
+
+If the new total is higher, the outstanding balance must be collected afterwards;
+if it is lower, the difference is owed back to the customer as a refund.
+
+Examples:
+  shopify-admin orders edit "#1001" --add-variant sku:TSHIRT-BLUE-L:1 --set-qty TSHIRT-BLUE-M:0
+  shopify-admin orders edit 1234567890 --set-qty 987654:3 --restock
+  shopify-admin orders edit "#1001" --discount TSHIRT-BLUE-L:10% --notify-customer --yes
+  shopify-admin orders edit "#1001" --add-variant 4567:2 --dry-run --json`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(orderEditAddVariants)+len(orderEditSetQtys)+len(orderEditDiscounts) == 0 {
+			return fmt.Errorf("nothing to edit — pass --add-variant, --set-qty, or --discount")
+		}
+		id, err := resolveID("Order", args[0])
+		if err != nil {
+			return err
+		}
+
+		// Validate and resolve everything before opening the edit session.
+		type addition struct {
+			variantID string
+			qty       int
+		}
+		var additions []addition
+		for _, spec := range orderEditAddVariants {
+			ref, qty, err := splitQuantitySpec(spec)
+			if err != nil {
+				return fmt.Errorf("--add-variant: %w", err)
+			}
+			if qty == 0 {
+				return fmt.Errorf("--add-variant %q: quantity must be at least 1", spec)
+			}
+			variantID, err := resolveID("ProductVariant", ref)
+			if err != nil {
+				return err
+			}
+			additions = append(additions, addition{variantID, qty})
+		}
+		type qtyChange struct {
+			line string
+			qty  int
+		}
+		var qtyChanges []qtyChange
+		for _, spec := range orderEditSetQtys {
+			line, qty, err := splitQuantitySpec(spec)
+			if err != nil {
+				return fmt.Errorf("--set-qty: %w", err)
+			}
+			qtyChanges = append(qtyChanges, qtyChange{line, qty})
+		}
+		type lineDiscount struct {
+			line     string
+			discount api.OrderEditDiscount
+		}
+		var discounts []lineDiscount
+		for _, spec := range orderEditDiscounts {
+			line, value, err := splitSpec(spec)
+			if err != nil {
+				return fmt.Errorf("--discount: %w", err)
+			}
+			d, err := parseOrderEditDiscount(value)
+			if err != nil {
+				return fmt.Errorf("--discount %q: %w", spec, err)
+			}
+			discounts = append(discounts, lineDiscount{line, d})
+		}
+
+		co, err := client.BeginOrderEdit(id)
+		if err != nil {
+			return err
+		}
+		for _, a := range additions {
+			if co, err = client.OrderEditAddVariant(co.ID, a.variantID, a.qty); err != nil {
+				return fmt.Errorf("adding variant %s: %w", shortID(a.variantID), err)
+			}
+		}
+		for _, q := range qtyChanges {
+			lineID, err := findCalculatedLine(co, q.line)
+			if err != nil {
+				return err
+			}
+			if co, err = client.OrderEditSetQuantity(co.ID, lineID, q.qty, orderEditRestock); err != nil {
+				return fmt.Errorf("setting quantity on %s: %w", q.line, err)
+			}
+		}
+		for _, d := range discounts {
+			lineID, err := findCalculatedLine(co, d.line)
+			if err != nil {
+				return err
+			}
+			d.discount.CurrencyCode = co.OriginalOrder.PresentmentCurrencyCode
+			if co, err = client.OrderEditAddLineItemDiscount(co.ID, lineID, d.discount); err != nil {
+				return fmt.Errorf("discounting %s: %w", d.line, err)
+			}
+		}
+
+		if orderEditDryRun {
+			if output.IsJSON(cmd) {
+				return output.PrintJSON(co, output.IsPretty(cmd))
+			}
+			printCalculatedOrder(co)
+			fmt.Println("\nDry run: the edit was not committed.")
+			return nil
+		}
+		if !output.IsJSON(cmd) {
+			printCalculatedOrder(co)
+			fmt.Println()
+		}
+		if !orderEditYes {
+			ok, err := confirm(fmt.Sprintf("Commit these changes to %s?", co.OriginalOrder.Name))
+			if err != nil {
+				return err
+			}
+			if !ok {
+				fmt.Fprintln(os.Stderr, "Edit discarded; the order was not changed.")
+				return nil
+			}
+		}
+		o, err := client.CommitOrderEdit(co.ID, orderEditNotify, orderEditStaffNote)
+		if err != nil {
+			return err
+		}
+		if output.IsJSON(cmd) {
+			return output.PrintJSON(map[string]any{"order": o, "calculatedOrder": co}, output.IsPretty(cmd))
+		}
+		fmt.Printf("Order %s updated. New total: %s\n", o.Name,
+			formatMoney(o.TotalPriceSet.ShopMoney.Amount, o.TotalPriceSet.ShopMoney.CurrencyCode))
+		if orderEditNotify {
+			fmt.Println("Customer notified.")
+		}
+		return nil
+	},
+}
+
+// parseOrderEditDiscount parses "10%" as a percentage and "5.00" as a fixed amount.
+func parseOrderEditDiscount(value string) (api.OrderEditDiscount, error) {
+	if pct, ok := strings.CutSuffix(value, "%"); ok {
+		f, err := strconv.ParseFloat(pct, 64)
+		if err != nil || f <= 0 || f > 100 {
+			return api.OrderEditDiscount{}, fmt.Errorf("invalid percentage %q", value)
+		}
+		return api.OrderEditDiscount{Percent: f, Description: value + " off"}, nil
+	}
+	f, err := strconv.ParseFloat(value, 64)
+	if err != nil || f <= 0 {
+		return api.OrderEditDiscount{}, fmt.Errorf("invalid amount %q: expected e.g. 10%% or 5.00", value)
+	}
+	return api.OrderEditDiscount{FixedAmount: value, Description: value + " off"}, nil
+}
+
+// findCalculatedLine returns the ID of the calculated line item matching ref.
+func findCalculatedLine(co *api.CalculatedOrder, ref string) (string, error) {
+	ids := make([]string, len(co.LineItems.Edges))
+	skus := make([]string, len(co.LineItems.Edges))
+	for i, e := range co.LineItems.Edges {
+		ids[i], skus[i] = e.Node.ID, e.Node.SKU
+	}
+	i, err := matchLineRef(ref, co.OriginalOrder.Name, ids, skus)
+	if err != nil {
+		return "", err
+	}
+	return ids[i], nil
+}
+
+// matchLineRef returns the index of the line matching ref, which is a line item ID
+// or GID, or a SKU (optionally prefixed with sku:).
+func matchLineRef(ref, orderName string, ids, skus []string) (int, error) {
+	if strings.HasPrefix(ref, "gid://") {
+		ref = shortID(ref)
+	}
+	if isNumeric(ref) {
+		for i, id := range ids {
+			if shortID(id) == ref {
+				return i, nil
+			}
+		}
+		return -1, fmt.Errorf("no line item %s on order %s", ref, orderName)
+	}
+	sku := strings.TrimPrefix(ref, "sku:")
+	var matches []int
+	for i, s := range skus {
+		if strings.EqualFold(s, sku) {
+			matches = append(matches, i)
+		}
+	}
+	switch len(matches) {
+	case 0:
+		return -1, fmt.Errorf("no line item with SKU %q on order %s", sku, orderName)
+	case 1:
+		return matches[0], nil
+	}
+	return -1, fmt.Errorf("SKU %q matches %d line items on %s — use the line item ID", sku, len(matches), orderName)
+}
+
+// stagedChangeLabels maps OrderStagedChange union members to short labels.
+var stagedChangeLabels = map[string]string{
+	"OrderStagedChangeAddVariant":          "added",
+	"OrderStagedChangeAddCustomItem":       "added",
+	"OrderStagedChangeIncrementItem":       "qty up",
+	"OrderStagedChangeDecrementItem":       "qty down",
+	"OrderStagedChangeAddLineItemDiscount": "discount",
+}
+
+// printCalculatedOrder prints the staged lines and recalculated totals of an order edit.
+func printCalculatedOrder(co *api.CalculatedOrder) {
+	fmt.Printf("Preview of %s:\n", co.OriginalOrder.Name)
+	headers := []string{"LINE", "TITLE", "SKU", "QTY", "UNIT PRICE", "DISCOUNTED", "CHANGE"}
+	rows := make([][]string, 0, len(co.LineItems.Edges))
+	for _, e := range co.LineItems.Edges {
+		li := e.Node
+		var changes []string
+		for _, sc := range li.StagedChanges {
+			label, ok := stagedChangeLabels[sc.TypeName]
+			if !ok {
+				label = strings.TrimPrefix(sc.TypeName, "OrderStagedChange")
+			}
+			changes = append(changes, label)
+		}
+		change := "-"
+		if len(changes) > 0 {
+			change = strings.Join(changes, ", ")
+		}
+		rows = append(rows, []string{
+			shortID(li.ID),
+			output.Truncate(li.Title, 36),
+			li.SKU,
+			fmt.Sprintf("%d", li.Quantity),
+			formatMoney(li.OriginalUnitPriceSet.ShopMoney.Amount, li.OriginalUnitPriceSet.ShopMoney.CurrencyCode),
+			formatMoney(li.DiscountedUnitPriceSet.ShopMoney.Amount, li.DiscountedUnitPriceSet.ShopMoney.CurrencyCode),
+			change,
+		})
+	}
+	output.PrintTable(headers, rows)
+	fmt.Println()
+	money := func(b api.MoneyBag) string {
+		return formatMoney(b.ShopMoney.Amount, b.ShopMoney.CurrencyCode)
+	}
+	output.PrintKeyValue([][]string{
+		{"Original total", money(co.OriginalOrder.TotalPriceSet)},
+		{"New subtotal", money(co.SubtotalPriceSet)},
+		{"Order discounts", money(co.CartDiscountAmountSet)},
+		{"New total", money(co.TotalPriceSet)},
+		{"Outstanding", money(co.TotalOutstandingSet) + outstandingHint(co.TotalOutstandingSet.ShopMoney.Amount)},
+	})
+}
+
+func outstandingHint(amount string) string {
+	switch f := parseAmount(amount); {
+	case f > 0:
+		return " (to collect from customer)"
+	case f < 0:
+		return " (to refund)"
+	}
+	return ""
+}
+
+func init() {
+	ordersEditCmd.Flags().StringArrayVar(&orderEditAddVariants, "add-variant", nil, "Add a variant: <variant>:<qty> (repeatable)")
+	ordersEditCmd.Flags().StringArrayVar(&orderEditSetQtys, "set-qty", nil, "Set a line's quantity: <line>:<qty> (repeatable)")
+	ordersEditCmd.Flags().StringArrayVar(&orderEditDiscounts, "discount", nil, "Discount a line: <line>:<10%|5.00> (repeatable)")
+	ordersEditCmd.Flags().BoolVar(&orderEditRestock, "restock", false, "Restock items removed by --set-qty")
+	ordersEditCmd.Flags().BoolVar(&orderEditNotify, "notify-customer", false, "Email the customer an updated invoice")
+	ordersEditCmd.Flags().StringVar(&orderEditStaffNote, "staff-note", "", "Internal note recorded with the edit")
+	ordersEditCmd.Flags().BoolVar(&orderEditDryRun, "dry-run", false, "Show the recalculated order without committing")
+	ordersEditCmd.Flags().BoolVar(&orderEditYes, "yes", false, "Commit without asking for confirmation")
+
+	ordersCmd.AddCommand(ordersEditCmd)
+}
@@ -0,0 +1,531 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/the20100/shopify-admin-cli/internal/api"
+	"github.com/the20100/shopify-admin-cli/internal/output"
+)
+
+// ---- orders export ----
+
+var (
+	orderExportSince    string
+	orderExportUntil    string
+	orderExportFormat   string
+	orderExportAccounts string
+	orderExportOutput   string
+	orderExportQuery    string
+)
+
+// accountMapping maps journal roles to ledger accounts. Gateways and Taxes override
+// Clearing and Tax for a payment gateway (e.g. shopify_payments, paypal, gift_card)
+// or a tax line title (e.g. "CA State Tax").
+type accountMapping struct {
+	Clearing   string            `yaml:"clearing"`
+	Receivable string            `yaml:"receivable"`
+	Sales      string            `yaml:"sales"`
+	Discounts  string            `yaml:"discounts"`
+	Shipping   string            `yaml:"shipping"`
+	Tax        string            `yaml:"tax"`
+	Refunds    string            `yaml:"refunds"`
+	Fees       string            `yaml:"fees"`
+	Rounding   string            `yaml:"rounding"`
+	Gateways   map[string]string `yaml:"gateways"`
+	Taxes      map[string]string `yaml:"taxes"`
+}
+
+var defaultAccounts = accountMapping{
+	Clearing:   "Payments clearing",
+	Receivable: "Accounts receivable",
+	Sales:      "Sales",
+	Discounts:  "Sales discounts",
+	Shipping:   "Shipping income",
+	Tax:        "Sales tax payable",
+	Refunds:    "Sales returns",
+	Fees:       "Payment processing fees",
+	Rounding:   "Rounding differences",
+}
+
+var ordersExportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export orders for accounting as line-level CSV or journal entries",
+	Long: `Export orders processed in a date range, flattened for accounting.
+
+Formats:
+  lines-csv     one row per line item (with its discounts and tax lines by
+                jurisdiction), plus rows for shipping, refunded items, refunded
+                shipping, refund adjustments, and payment fees. Refunds and fees
+                are negative.
+  journal-csv   balanced double-entry journal: one entry per order, per refund,
+                and per transaction fee, with debit and credit columns.
+
+Amounts are in the shop currency. Refunds are exported with their order and
+dated when they were issued, so a refund in this period on an order from an
+earlier period is not included; export a wider range to catch those.
+
+An order with more than export can read (over 100 payment transactions, or a
+refund with over 50 transactions or 10 shipping lines) is skipped with a warning.
+
+--since and --until take a date (2024-06-01) or a relative period (-1m).
+--until is exclusive.
+
+--accounts maps journal roles to your chart of accounts (YAML or JSON):
+
+  clearing: "1100 Payments clearing"
+  receivable: "1200 Accounts receivable"
+  sales: "4000 Sales"
+  discounts: "4100 Discounts"
+  shipping: "4200 Shipping income"
+  tax: "2200 Sales tax payable"
+  refunds: "4300 Sales returns"
+  fees: "6100 Payment fees"
+  rounding: "6900 Rounding"
+  gateways:
+    shopify_payments: "1110 Shopify Payments clearing"
+    gift_card: "2300 Gift card liability"
+  taxes:
+    "CA State Tax": "2210 CA sales tax"
+
+Examples:
+  shopify-admin orders export --since 2024-06-01 --until 2024-07-01 --output june-lines.csv
+  shopify-admin orders export --since -1m --format journal-csv --accounts accounts.yaml > journal.csv
+  shopify-admin orders export --since 2024-06-01 --query "tag:wholesale" --json`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if orderExportFormat != "lines-csv" && orderExportFormat != "journal-csv" {
+			return fmt.Errorf("invalid --format %q: expected lines-csv or journal-csv", orderExportFormat)
+		}
+		since, err := parseSince("--since", orderExportSince)
+		if err != nil {
+			return err
+		}
+		query := "processed_at:>='" + since.Format(time.RFC3339) + "'"
+		if orderExportUntil != "" {
+			until, err := parseSince("--until", orderExportUntil)
+			if err != nil {
+				return err
+			}
+			if !until.After(since) {
+				return fmt.Errorf("--until must be after --since")
+			}
+			query += " processed_at:<'" + until.Format(time.RFC3339) + "'"
+		}
+		if orderExportQuery != "" {
+			query += " " + orderExportQuery
+		}
+		accounts := defaultAccounts
+		if orderExportAccounts != "" {
+			if err := loadSpecFile(orderExportAccounts, &accounts); err != nil {
+				return err
+			}
+			accounts.fillDefaults()
+		}
+
+		var orders []api.ExportOrder
+		after := ""
+		for {
+			conn, err := client.ExportOrders(25, after, query)
+			if err != nil {
+				return err
+			}
+			for _, e := range conn.Edges {
+				o := e.Node
+				if err := client.CompleteExportOrder(&o); err != nil {
+					var limit *api.ExportLimitError
+					if !errors.As(err, &limit) {
+						return err
+					}
+					fmt.Fprintf(os.Stderr, "Warning: skipping %s.\n", err)
+					continue
+				}
+				orders = append(orders, o)
+			}
+			if !conn.PageInfo.HasNextPage {
+				break
+			}
+			after = conn.PageInfo.EndCursor
+		}
+
+		if jsonRequested(cmd) {
+			if orders == nil {
+				orders = []api.ExportOrder{}
+			}
+			return output.PrintJSON(orders, output.IsPretty(cmd))
+		}
+
+		var w io.Writer = os.Stdout
+		if orderExportOutput != "" {
+			f, err := os.Create(orderExportOutput)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			w = f
+		}
+		var headers []string
+		var rows [][]string
+		if orderExportFormat == "lines-csv" {
+			headers = exportLineHeaders
+			for _, o := range orders {
+				rows = append(rows, exportLineRows(o)...)
+			}
+		} else {
+			headers = journalHeaders
+			for _, o := range orders {
+				rows = append(rows, journalRows(o, accounts)...)
+			}
+		}
+		if err := output.WriteCSV(w, headers, rows); err != nil {
+			return err
+		}
+		if orderExportOutput != "" {
+			fmt.Fprintf(os.Stderr, "Exported %d orders (%d rows) to %s.\n", len(orders), len(rows), orderExportOutput)
+		}
+		return nil
+	},
+}
+
+func (m *accountMapping) fillDefaults() {
+	for _, f := range []struct {
+		v   *string
+		def string
+	}{
+		{&m.Clearing, defaultAccounts.Clearing},
+		{&m.Receivable, defaultAccounts.Receivable},
+		{&m.Sales, defaultAccounts.Sales},
+		{&m.Discounts, defaultAccounts.Discounts},
+		{&m.Shipping, defaultAccounts.Shipping},
+		{&m.Tax, defaultAccounts.Tax},
+		{&m.Refunds, defaultAccounts.Refunds},
+		{&m.Fees, defaultAccounts.Fees},
+		{&m.Rounding, defaultAccounts.Rounding},
+	} {
+		if *f.v == "" {
+			*f.v = f.def
+		}
+	}
+}
+
+func (m accountMapping) gateway(name string) string {
+	if a, ok := m.Gateways[name]; ok && a != "" {
+		return a
+	}
+	return m.Clearing
+}
+
+func (m accountMapping) taxLine(title string) string {
+	if a, ok := m.Taxes[title]; ok && a != "" {
+		return a
+	}
+	return m.Tax
+}
+
+// ── lines-csv ─────────────────────────────────────────────────────────────────
+
+var exportLineHeaders = []string{
+	"order", "order_id", "date", "currency", "row_type", "line_id", "sku", "title",
+	"quantity", "unit_price", "gross", "discount", "discount_codes", "tax", "tax_detail", "net", "total",
+}
+
+func shopAmount(b api.MoneyBag) float64 { return parseAmount(b.ShopMoney.Amount) }
+
+func amountStr(f float64) string {
+	f = round2(f)
+	if f == 0 {
+		f = 0 // avoid "-0.00"
+	}
+	return fmt.Sprintf("%.2f", f)
+}
+
+func sumTaxLines(lines []api.TaxLine) float64 {
+	total := 0.0
+	for _, t := range lines {
+		total += shopAmount(t.PriceSet)
+	}
+	return total
+}
+
+// taxDetail renders tax lines as "CA State Tax 6%: 1.20; LA County Tax 1%: 0.20".
+func taxDetail(lines []api.TaxLine) string {
+	parts := make([]string, len(lines))
+	for i, t := range lines {
+		parts[i] = fmt.Sprintf("%s %g%%: %s", t.Title, t.RatePercentage, amountStr(shopAmount(t.PriceSet)))
+	}
+	return strings.Join(parts, "; ")
+}
+
+func sumDiscounts(allocs []api.DiscountAllocation) (float64, string) {
+	total := 0.0
+	var names []string
+	for _, d := range allocs {
+		total += shopAmount(d.AllocatedAmountSet)
+		name := d.DiscountApplication.Code
+		if name == "" {
+			name = d.DiscountApplication.Title
+		}
+		if name != "" {
+			names = append(names, name)
+		}
+	}
+	return total, strings.Join(names, "; ")
+}
+
+// exportLineRows flattens an order into line, shipping, refund, and fee rows.
+func exportLineRows(o api.ExportOrder) [][]string {
+	cur := o.TotalPriceSet.ShopMoney.CurrencyCode
+	date := output.FormatDate(&o.ProcessedAt)
+	row := func(date, rowType, lineID, sku, title, qty, unit string, gross, discount float64, codes string, tax float64, detail string, net, total float64) []string {
+		return []string{
+			o.Name, shortID(o.ID), date, cur, rowType, lineID, sku, title, qty, unit,
+			amountStr(gross), amountStr(discount), codes, amountStr(tax), detail, amountStr(net), amountStr(total),
+		}
+	}
+	var rows [][]string
+	for _, e := range o.LineItems.Edges {
+		li := e.Node
+		gross := shopAmount(li.OriginalUnitPriceSet) * float64(li.Quantity)
+		discount, codes := sumDiscounts(li.DiscountAllocations)
+		tax := sumTaxLines(li.TaxLines)
+		net := gross - discount
+		if o.TaxesIncluded {
+			net -= tax
+		}
+		title := li.Title
+		if li.VariantTitle != "" {
+			title += " - " + li.VariantTitle
+		}
+		rows = append(rows, row(date, "line", shortID(li.ID), li.SKU, title,
+			fmt.Sprintf("%d", li.Quantity), amountStr(shopAmount(li.OriginalUnitPriceSet)),
+			gross, discount, codes, tax, taxDetail(li.TaxLines), net, net+tax))
+	}
+	for _, e := range o.ShippingLines.Edges {
+		sl := e.Node
+		gross := shopAmount(sl.OriginalPriceSet)
+		discount, codes := sumDiscounts(sl.DiscountAllocations)
+		tax := sumTaxLines(sl.TaxLines)
+		net := gross - discount
+		if o.TaxesIncluded {
+			net -= tax
+		}
+		rows = append(rows, row(date, "shipping", "", sl.Code, sl.Title, "", "",
+			gross, discount, codes, tax, taxDetail(sl.TaxLines), net, net+tax))
+	}
+	for _, r := range o.Refunds {
+		rdate := output.FormatDate(&r.CreatedAt)
+		accounted := 0.0
+		for _, e := range r.RefundLineItems.Edges {
+			rl := e.Node
+			sub, tax := shopAmount(rl.SubtotalSet), shopAmount(rl.TotalTaxSet)
+			accounted += sub + tax
+			rows = append(rows, row(rdate, "refund", shortID(rl.LineItem.ID), rl.LineItem.SKU, rl.LineItem.Title,
+				fmt.Sprintf("%d", -rl.Quantity), "", -sub, 0, "", -tax, "", -sub, -(sub+tax)))
+		}
+		for _, e := range r.RefundShippingLines.Edges {
+			rs := e.Node
+			sub, tax := shopAmount(rs.SubtotalAmountSet), shopAmount(rs.TaxAmountSet)
+			accounted += sub + tax
+			rows = append(rows, row(rdate, "refund-shipping", "", "", "Shipping refund", "", "",
+				-sub, 0, "", -tax, "", -sub, -(sub+tax)))
+		}
+		if adj := shopAmount(r.TotalRefundedSet) - accounted; math.Abs(adj) >= 0.005 {
+			rows = append(rows, row(rdate, "refund-adjustment", "", "", orDash(r.Note), "", "",
+				-adj, 0, "", 0, "", -adj, -adj))
+		}
+	}
+	for _, t := range o.Transactions {
+		for _, f := range t.Fees {
+			amt := parseAmount(f.Amount.Amount) + parseAmount(f.TaxAmount.Amount)
+			title := strings.TrimSpace(t.Gateway + " " + strings.ToLower(f.Type) + " fee " + f.RateName)
+			rows = append(rows, row(output.FormatDate(&t.ProcessedAt), "fee", shortID(t.ID), "", title, "", "",
+				-parseAmount(f.Amount.Amount), 0, "", -parseAmount(f.TaxAmount.Amount), "", -parseAmount(f.Amount.Amount), -amt))
+		}
+	}
+	return rows
+}
+
+// ── journal-csv ───────────────────────────────────────────────────────────────
+
+var journalHeaders = []string{"date", "entry", "account", "description", "debit", "credit", "currency"}
+
+// journalEntry accumulates debits and credits per account and description.
+type journalEntry struct {
+	date, name, currency string
+	keys                 []string
+	lines                map[string]*journalLine
+}
+
+type journalLine struct {
+	account, description string
+	net                  float64 // debit positive, credit negative
+}
+
+func newJournalEntry(date, name, currency string) *journalEntry {
+	return &journalEntry{date: date, name: name, currency: currency, lines: map[string]*journalLine{}}
+}
+
+func (j *journalEntry) post(account, description string, debit float64) {
+	if math.Abs(debit) < 0.005 {
+		return
+	}
+	key := account + "\x00" + description
+	l, ok := j.lines[key]
+	if !ok {
+		l = &journalLine{account: account, description: description}
+		j.lines[key] = l
+		j.keys = append(j.keys, key)
+	}
+	l.net += debit
+}
+
+// rows balances the entry against the rounding account and renders it.
+func (j *journalEntry) rows(rounding string) [][]string {
+	balance := 0.0
+	for _, l := range j.lines {
+		balance += l.net
+	}
+	j.post(rounding, "Rounding", -balance)
+	var rows [][]string
+	for _, k := range j.keys {
+		l := j.lines[k]
+		debit, credit := "", ""
+		switch n := round2(l.net); {
+		case n > 0:
+			debit = amountStr(n)
+		case n < 0:
+			credit = amountStr(-n)
+		default:
+			continue
+		}
+		rows = append(rows, []string{j.date, j.name, l.account, l.description, debit, credit, j.currency})
+	}
+	return rows
+}
+
+// debitTaxes posts refunded tax to the accounts of the tax lines it was charged
+// on, split in proportion to what each jurisdiction charged. Refunds only report a
+// total, so without tax lines to go by it goes to the default tax account.
+func debitTaxes(entry *journalEntry, m accountMapping, lines []api.TaxLine, amount float64) {
+	total := sumTaxLines(lines)
+	if total == 0 {
+		entry.post(m.Tax, "Refunded tax", amount)
+		return
+	}
+	for _, t := range lines {
+		entry.post(m.taxLine(t.Title), "Refunded tax: "+t.Title, amount*shopAmount(t.PriceSet)/total)
+	}
+}
+
+// journalRows builds the sale, refund, and fee entries for an order.
+func journalRows(o api.ExportOrder, m accountMapping) [][]string {
+	cur := o.TotalPriceSet.ShopMoney.CurrencyCode
+	sale := newJournalEntry(output.FormatDate(&o.ProcessedAt), o.Name, cur)
+	creditTaxes := func(lines []api.TaxLine) float64 {
+		for _, t := range lines {
+			sale.post(m.taxLine(t.Title), "Tax: "+t.Title, -shopAmount(t.PriceSet))
+		}
+		return sumTaxLines(lines)
+	}
+	for _, e := range o.LineItems.Edges {
+		li := e.Node
+		discount, _ := sumDiscounts(li.DiscountAllocations)
+		tax := creditTaxes(li.TaxLines)
+		gross := shopAmount(li.OriginalUnitPriceSet) * float64(li.Quantity)
+		if o.TaxesIncluded {
+			gross -= tax
+		}
+		sale.post(m.Sales, "Sales", -gross)
+		sale.post(m.Discounts, "Discounts", discount)
+	}
+	for _, e := range o.ShippingLines.Edges {
+		sl := e.Node
+		discount, _ := sumDiscounts(sl.DiscountAllocations)
+		tax := creditTaxes(sl.TaxLines)
+		gross := shopAmount(sl.OriginalPriceSet)
+		if o.TaxesIncluded {
+			gross -= tax
+		}
+		sale.post(m.Shipping, "Shipping", -gross)
+		sale.post(m.Discounts, "Shipping discounts", discount)
+	}
+	collected := 0.0
+	for _, t := range o.Transactions {
+		if (t.Kind == "SALE" || t.Kind == "CAPTURE") && t.Status == "SUCCESS" {
+			amt := shopAmount(t.AmountSet)
+			collected += amt
+			sale.post(m.gateway(t.Gateway), "Payment: "+t.Gateway, amt)
+		}
+	}
+	sale.post(m.Receivable, "Unpaid balance", shopAmount(o.TotalPriceSet)-collected)
+	rows := sale.rows(m.Rounding)
+
+	lineTaxes := map[string][]api.TaxLine{}
+	for _, e := range o.LineItems.Edges {
+		lineTaxes[e.Node.ID] = e.Node.TaxLines
+	}
+	var shippingTaxes []api.TaxLine
+	for _, e := range o.ShippingLines.Edges {
+		shippingTaxes = append(shippingTaxes, e.Node.TaxLines...)
+	}
+	for _, r := range o.Refunds {
+		entry := newJournalEntry(output.FormatDate(&r.CreatedAt), o.Name+" refund "+shortID(r.ID), cur)
+		for _, e := range r.RefundLineItems.Edges {
+			entry.post(m.Refunds, "Returned items", shopAmount(e.Node.SubtotalSet))
+			debitTaxes(entry, m, lineTaxes[e.Node.LineItem.ID], shopAmount(e.Node.TotalTaxSet))
+		}
+		for _, e := range r.RefundShippingLines.Edges {
+			entry.post(m.Shipping, "Refunded shipping", shopAmount(e.Node.SubtotalAmountSet))
+			debitTaxes(entry, m, shippingTaxes, shopAmount(e.Node.TaxAmountSet))
+		}
+		paid := 0.0
+		for _, e := range r.Transactions.Edges {
+			t := e.Node
+			if t.Kind == "REFUND" && t.Status == "SUCCESS" {
+				amt := shopAmount(t.AmountSet)
+				paid += amt
+				entry.post(m.gateway(t.Gateway), "Refund: "+t.Gateway, -amt)
+			}
+		}
+		// Whatever the line and shipping amounts don't explain (manual amounts,
+		// restocking fees) is a sales return adjustment.
+		accounted := 0.0
+		for _, l := range entry.lines {
+			if l.net > 0 {
+				accounted += l.net
+			}
+		}
+		entry.post(m.Refunds, "Refund adjustment", paid-accounted)
+		rows = append(rows, entry.rows(m.Rounding)...)
+	}
+
+	for _, t := range o.Transactions {
+		if len(t.Fees) == 0 {
+			continue
+		}
+		entry := newJournalEntry(output.FormatDate(&t.ProcessedAt), o.Name+" fees "+shortID(t.ID), cur)
+		for _, f := range t.Fees {
+			amt := parseAmount(f.Amount.Amount) + parseAmount(f.TaxAmount.Amount)
+			entry.post(m.Fees, strings.TrimSpace("Fee: "+strings.ToLower(f.Type)+" "+f.RateName), amt)
+			entry.post(m.gateway(t.Gateway), "Fee: "+t.Gateway, -amt)
+		}
+		rows = append(rows, entry.rows(m.Rounding)...)
+	}
+	return rows
+}
+
+func init() {
+	ordersExportCmd.Flags().StringVar(&orderExportSince, "since", "-1m", "Orders processed on or after this date or relative period")
+	ordersExportCmd.Flags().StringVar(&orderExportUntil, "until", "", "Orders processed before this date or relative period")
+	ordersExportCmd.Flags().StringVar(&orderExportFormat, "format", "lines-csv", "Export format: lines-csv or journal-csv")
+	ordersExportCmd.Flags().StringVar(&orderExportAccounts, "accounts", "", "Account mapping file (YAML or JSON) for journal-csv")
+	ordersExportCmd.Flags().StringVar(&orderExportOutput, "output", "", "Write CSV to this file instead of stdout")
+	ordersExportCmd.Flags().StringVar(&orderExportQuery, "query", "", "Additional Shopify search query")
+
+	ordersCmd.AddCommand(ordersExportCmd)
+}
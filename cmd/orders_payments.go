@@ -0,0 +1,331 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/the20100/shopify-admin-cli/internal/api"
+	"github.com/the20100/shopify-admin-cli/internal/output"
+)
+
+// ---- orders transactions ----
+
+var ordersTransactionsCmd = &cobra.Command{
+	Use:   "transactions <id>",
+	Short: "List payment transactions on an order",
+	Long: `List an order's payment transactions: authorizations, captures, sales, refunds
+and voids, with the authorization expiry and the amount still capturable.
+
+Examples:
+  shopify-admin orders transactions "#1001"
+  shopify-admin orders transactions 1234567890 --json`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := resolveID("Order", args[0])
+		if err != nil {
+			return err
+		}
+		o, err := client.ListOrderTransactions(id)
+		if err != nil {
+			return err
+		}
+		if output.IsJSON(cmd) {
+			return output.PrintJSON(o.Transactions, output.IsPretty(cmd))
+		}
+		if len(o.Transactions) == 0 {
+			fmt.Printf("No transactions found on %s.\n", o.Name)
+			return nil
+		}
+		headers := []string{"ID", "KIND", "STATUS", "GATEWAY", "AMOUNT", "PARENT", "CREATED", "AUTH EXPIRES"}
+		rows := make([][]string, len(o.Transactions))
+		for i, t := range o.Transactions {
+			parent := "-"
+			if t.ParentTransaction != nil {
+				parent = shortID(t.ParentTransaction.ID)
+			}
+			status := strings.ToLower(t.Status)
+			if t.ErrorCode != "" {
+				status += " (" + strings.ToLower(t.ErrorCode) + ")"
+			}
+			rows[i] = []string{
+				shortID(t.ID),
+				strings.ToLower(t.Kind),
+				status,
+				t.Gateway,
+				formatMoney(t.AmountSet.ShopMoney.Amount, t.AmountSet.ShopMoney.CurrencyCode),
+				parent,
+				output.FormatTime(t.CreatedAt),
+				output.FormatTime(t.AuthorizationExpiresAt),
+			}
+		}
+		output.PrintTable(headers, rows)
+		fmt.Println()
+		capturable := "-"
+		if o.TotalCapturableSet != nil && parseAmount(o.TotalCapturableSet.ShopMoney.Amount) > 0 {
+			capturable = formatMoney(o.TotalCapturableSet.ShopMoney.Amount, o.TotalCapturableSet.ShopMoney.CurrencyCode)
+		}
+		output.PrintKeyValue([][]string{
+			{"Order", o.Name},
+			{"Financial", strings.ToLower(o.FinancialStatus)},
+			{"Total", formatMoney(o.TotalPriceSet.ShopMoney.Amount, o.TotalPriceSet.ShopMoney.CurrencyCode)},
+			{"Capturable", capturable},
+		})
+		return nil
+	},
+}
+
+// ---- orders capture ----
+
+var (
+	orderCaptureAmount      string
+	orderCaptureTransaction string
+)
+
+var ordersCaptureCmd = &cobra.Command{
+	Use:   "capture <id>",
+	Short: "Capture payment on an authorized order",
+	Long: `Capture an authorized payment. Without --amount, the full remaining authorized
+amount is captured. Amounts are in the order's presentment (customer) currency.
+
+If the order has more than one open authorization, pick one with --transaction
+(see 'orders transactions').
+
+Examples:
+  shopify-admin orders capture "#1001"
+  shopify-admin orders capture 1234567890 --amount 25.00
+  shopify-admin orders capture "#1001" --transaction 5551234 --json`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := resolveID("Order", args[0])
+		if err != nil {
+			return err
+		}
+		o, err := client.ListOrderTransactions(id)
+		if err != nil {
+			return err
+		}
+		auth, err := captureParent(o, orderCaptureTransaction)
+		if err != nil {
+			return err
+		}
+		remaining := presentment(*auth.TotalUnsettledSet)
+		amount := remaining.Amount
+		if orderCaptureAmount != "" {
+			f, err := strconv.ParseFloat(orderCaptureAmount, 64)
+			if err != nil || f <= 0 {
+				return fmt.Errorf("invalid --amount %q", orderCaptureAmount)
+			}
+			if f > parseAmount(remaining.Amount)+0.005 {
+				return fmt.Errorf("--amount %s exceeds the capturable %s",
+					orderCaptureAmount, formatMoney(remaining.Amount, remaining.CurrencyCode))
+			}
+			amount = orderCaptureAmount
+		}
+		if exp, err := time.Parse(time.RFC3339, auth.AuthorizationExpiresAt); err == nil && exp.Before(time.Now()) {
+			fmt.Fprintf(os.Stderr, "Warning: authorization %s expired on %s; the gateway may decline the capture.\n",
+				shortID(auth.ID), output.FormatTime(auth.AuthorizationExpiresAt))
+		}
+		t, err := client.CaptureOrder(id, auth.ID, amount, remaining.CurrencyCode)
+		if err != nil {
+			return err
+		}
+		if output.IsJSON(cmd) {
+			return output.PrintJSON(t, output.IsPretty(cmd))
+		}
+		captured := presentment(t.AmountSet)
+		fmt.Printf("Captured %s on %s (transaction %s, %s).\n",
+			formatMoney(captured.Amount, captured.CurrencyCode), o.Name, shortID(t.ID), strings.ToLower(t.Status))
+		return nil
+	},
+}
+
+// captureParent returns the authorization to capture against: the one matching
+// ref, or the only successful authorization with an amount left to capture.
+func captureParent(o *api.Order, ref string) (*api.OrderTransaction, error) {
+	var open []*api.OrderTransaction
+	for i := range o.Transactions {
+		t := &o.Transactions[i]
+		if t.Kind != "AUTHORIZATION" || t.Status != "SUCCESS" {
+			continue
+		}
+		if ref != "" && shortID(t.ID) == shortID(ref) {
+			if t.TotalUnsettledSet == nil || parseAmount(t.TotalUnsettledSet.ShopMoney.Amount) <= 0 {
+				return nil, fmt.Errorf("authorization %s has nothing left to capture", shortID(t.ID))
+			}
+			return t, nil
+		}
+		if t.TotalUnsettledSet != nil && parseAmount(t.TotalUnsettledSet.ShopMoney.Amount) > 0 {
+			open = append(open, t)
+		}
+	}
+	if ref != "" {
+		return nil, fmt.Errorf("no successful authorization %s on order %s", shortID(ref), o.Name)
+	}
+	switch len(open) {
+	case 0:
+		return nil, fmt.Errorf("order %s has no authorized payment to capture (financial status: %s)",
+			o.Name, strings.ToLower(o.FinancialStatus))
+	case 1:
+		return open[0], nil
+	}
+	ids := make([]string, len(open))
+	for i, t := range open {
+		ids[i] = shortID(t.ID)
+	}
+	return nil, fmt.Errorf("order %s has %d open authorizations (%s) — pick one with --transaction",
+		o.Name, len(open), strings.Join(ids, ", "))
+}
+
+// ---- orders authorizations ----
+
+var (
+	orderAuthQuery  string
+	orderAuthWithin int
+)
+
+type authorizationRow struct {
+	Order       string `json:"order"`
+	OrderID     string `json:"orderId"`
+	Transaction string `json:"transactionId"`
+	Customer    string `json:"customer"`
+	Gateway     string `json:"gateway"`
+	Capturable  string `json:"capturable"`
+	Currency    string `json:"currency"`
+	Fulfillment string `json:"fulfillment"`
+	ExpiresAt   string `json:"expiresAt"`
+}
+
+var ordersAuthorizationsCmd = &cobra.Command{
+	Use:   "authorizations",
+	Short: "List uncaptured authorizations, soonest to expire first",
+	Long: `List every order with an authorized but uncaptured payment, sorted by when the
+authorization expires. Expired authorizations are listed first; authorizations
+with no known expiry are listed last.
+
+--query narrows the orders further (Shopify search syntax, combined with
+financial_status:authorized).
+
+Examples:
+  shopify-admin orders authorizations
+  shopify-admin orders authorizations --expiring-within 2
+  shopify-admin orders authorizations --query "fulfillment_status:unfulfilled" --json`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		query := "financial_status:authorized"
+		if orderAuthQuery != "" {
+			query += " " + orderAuthQuery
+		}
+		now := time.Now()
+		var cutoff time.Time
+		if orderAuthWithin > 0 {
+			cutoff = now.AddDate(0, 0, orderAuthWithin)
+		}
+		var report []authorizationRow
+		after := ""
+		for {
+			conn, err := client.ListAuthorizedOrders(10, after, query)
+			if err != nil {
+				return err
+			}
+			for _, e := range conn.Edges {
+				o := e.Node
+				customer := "-"
+				if o.Customer != nil {
+					customer = strings.TrimSpace(o.Customer.FirstName + " " + o.Customer.LastName)
+				}
+				for _, t := range o.Transactions {
+					if t.Kind != "AUTHORIZATION" || t.TotalUnsettledSet == nil {
+						continue
+					}
+					if !cutoff.IsZero() {
+						exp, err := time.Parse(time.RFC3339, t.AuthorizationExpiresAt)
+						if err != nil || exp.After(cutoff) {
+							continue
+						}
+					}
+					report = append(report, authorizationRow{
+						Order:       o.Name,
+						OrderID:     shortID(o.ID),
+						Transaction: shortID(t.ID),
+						Customer:    customer,
+						Gateway:     t.Gateway,
+						Capturable:  t.TotalUnsettledSet.ShopMoney.Amount,
+						Currency:    t.TotalUnsettledSet.ShopMoney.CurrencyCode,
+						Fulfillment: strings.ToLower(o.DisplayFulfillmentStatus),
+						ExpiresAt:   t.AuthorizationExpiresAt,
+					})
+				}
+			}
+			if !conn.PageInfo.HasNextPage {
+				break
+			}
+			after = conn.PageInfo.EndCursor
+		}
+		sort.SliceStable(report, func(i, j int) bool {
+			a, b := report[i].ExpiresAt, report[j].ExpiresAt
+			if a == "" || b == "" {
+				return b == "" && a != ""
+			}
+			return a < b
+		})
+
+		if output.IsJSON(cmd) {
+			return output.PrintJSON(report, output.IsPretty(cmd))
+		}
+		if len(report) == 0 {
+			fmt.Println("No uncaptured authorizations found.")
+			return nil
+		}
+		headers := []string{"ORDER", "ID", "CUSTOMER", "CAPTURABLE", "GATEWAY", "FULFILLMENT", "EXPIRES", "EXPIRES IN"}
+		rows := make([][]string, len(report))
+		for i, r := range report {
+			rows[i] = []string{
+				r.Order,
+				r.OrderID,
+				output.Truncate(r.Customer, 24),
+				formatMoney(r.Capturable, r.Currency),
+				r.Gateway,
+				r.Fulfillment,
+				output.FormatTime(r.ExpiresAt),
+				expiresIn(r.ExpiresAt, now),
+			}
+		}
+		output.PrintTable(headers, rows)
+		return nil
+	},
+}
+
+// expiresIn renders the time left before an ISO-8601 timestamp as "2d 4h", "3h",
+// or "expired".
+func expiresIn(ts string, now time.Time) string {
+	t, err := time.Parse(time.RFC3339, ts)
+	if err != nil {
+		return "-"
+	}
+	d := t.Sub(now)
+	if d <= 0 {
+		return "expired"
+	}
+	days, hours := int(d.Hours())/24, int(d.Hours())%24
+	if days > 0 {
+		return fmt.Sprintf("%dd %dh", days, hours)
+	}
+	if hours > 0 {
+		return fmt.Sprintf("%dh", hours)
+	}
+	return fmt.Sprintf("%dm", int(d.Minutes()))
+}
+
+func init() {
+	ordersCaptureCmd.Flags().StringVar(&orderCaptureAmount, "amount", "", "Amount to capture (default: everything still authorized)")
+	ordersCaptureCmd.Flags().StringVar(&orderCaptureTransaction, "transaction", "", "Authorization transaction ID to capture against")
+
+	ordersAuthorizationsCmd.Flags().StringVar(&orderAuthQuery, "query", "", "Additional Shopify search query")
+	ordersAuthorizationsCmd.Flags().IntVar(&orderAuthWithin, "expiring-within", 0, "Only show authorizations expiring within N days (including expired)")
+
+	ordersCmd.AddCommand(ordersTransactionsCmd, ordersCaptureCmd, ordersAuthorizationsCmd)
+}
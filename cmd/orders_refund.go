@@ -0,0 +1,347 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/the20100/shopify-admin-cli/internal/api"
+	"github.com/the20100/shopify-admin-cli/internal/output"
+)
+
+// ---- orders refund ----
+
+var (
+	orderRefundLines           []string
+	orderRefundShipping        string
+	orderRefundAmount          string
+	orderRefundRestockLocation string
+	orderRefundNote            string
+	orderRefundNotify          bool
+	orderRefundDryRun          bool
+	orderRefundYes             bool
+)
+
+var ordersRefundCmd = &cobra.Command{
+	Use:   "refund <id>",
+	Short: "Refund line items, shipping, or a custom amount",
+	Long: `Refund part or all of an order. Shopify first calculates the refund (amounts, tax,
+and which payment transactions to refund); it is created only after you confirm
+(or pass --yes).
+
+  --line <line>:<qty>        line item ID or SKU (see 'orders get'); repeatable
+  --shipping full|<amount>   refund all remaining shipping, or a fixed amount
+  --amount <amount>          refund this amount instead of the calculated total
+  --restock-location <id>    put refunded items back in stock at this location
+
+Without --restock-location, refunded items are not restocked. With it, unfulfilled
+items are cancelled and fulfilled items returned to stock at that location.
+
+Examples:
+  shopify-admin orders refund "#1001" --line TSHIRT-BLUE-M:1
+  shopify-admin orders refund "#1001" --line TSHIRT-BLUE-M:1 --restock-location 67890 --notify
+  shopify-admin orders refund 1234567890 --shipping full --note "Late delivery"
+  shopify-admin orders refund 1234567890 --amount 10.00 --note "Goodwill" --yes
+  shopify-admin orders refund "#1001" --line 987654:2 --dry-run --json`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(orderRefundLines) == 0 && orderRefundShipping == "" && orderRefundAmount == "" {
+			return fmt.Errorf("nothing to refund — pass --line, --shipping, or --amount")
+		}
+		fullShipping, shippingAmount := false, ""
+		switch strings.ToLower(orderRefundShipping) {
+		case "":
+		case "full":
+			fullShipping = true
+		default:
+			if f, err := strconv.ParseFloat(orderRefundShipping, 64); err != nil || f <= 0 {
+				return fmt.Errorf("invalid --shipping %q: expected full or an amount", orderRefundShipping)
+			}
+			shippingAmount = orderRefundShipping
+		}
+		if orderRefundAmount != "" {
+			if f, err := strconv.ParseFloat(orderRefundAmount, 64); err != nil || f <= 0 {
+				return fmt.Errorf("invalid --amount %q", orderRefundAmount)
+			}
+		}
+
+		id, err := resolveID("Order", args[0])
+		if err != nil {
+			return err
+		}
+		o, err := client.GetOrder(id)
+		if err != nil {
+			return err
+		}
+		locationID := ""
+		if orderRefundRestockLocation != "" {
+			if locationID, err = resolveID("Location", orderRefundRestockLocation); err != nil {
+				return err
+			}
+		}
+		lines, err := refundLineInputs(o, orderRefundLines, locationID)
+		if err != nil {
+			return err
+		}
+
+		// A custom amount with nothing else to refund still needs the order's
+		// refundable transactions, so ask for a full-refund suggestion to find them.
+		suggestFull := orderRefundAmount != "" && len(lines) == 0 && orderRefundShipping == ""
+		suggestion, currency, err := client.SuggestRefund(o.ID, lines, fullShipping, shippingAmount, suggestFull)
+		if err != nil {
+			return err
+		}
+		txs, err := refundTransactions(suggestion, orderRefundAmount)
+		if err != nil {
+			return err
+		}
+		input := api.RefundInput{
+			OrderID:        o.ID,
+			Note:           orderRefundNote,
+			Notify:         orderRefundNotify,
+			Currency:       currency,
+			FullShipping:   fullShipping,
+			ShippingAmount: shippingAmount,
+			LineItems:      lines,
+			Transactions:   txs,
+		}
+
+		if orderRefundDryRun {
+			if output.IsJSON(cmd) {
+				return output.PrintJSON(suggestion, output.IsPretty(cmd))
+			}
+			printSuggestedRefund(o.Name, suggestion, txs, currency)
+			fmt.Println("\nDry run: no refund was created.")
+			return nil
+		}
+		if !output.IsJSON(cmd) {
+			printSuggestedRefund(o.Name, suggestion, txs, currency)
+			fmt.Println()
+		}
+		if !orderRefundYes {
+			ok, err := confirm(fmt.Sprintf("Refund %s on %s?", transactionsTotal(txs, currency), o.Name))
+			if err != nil {
+				return err
+			}
+			if !ok {
+				fmt.Fprintln(os.Stderr, "Refund cancelled; nothing was changed.")
+				return nil
+			}
+		}
+		r, err := client.CreateRefund(input)
+		if err != nil {
+			return err
+		}
+		if output.IsJSON(cmd) {
+			return output.PrintJSON(r, output.IsPretty(cmd))
+		}
+		fmt.Printf("Refund %s created on %s: %s\n", shortID(r.ID), o.Name,
+			formatMoney(r.TotalRefundedSet.ShopMoney.Amount, r.TotalRefundedSet.ShopMoney.CurrencyCode))
+		return nil
+	},
+}
+
+// refundLineInputs turns --line specs into refund line items, choosing CANCEL for
+// unfulfilled quantities and RETURN for fulfilled ones when restocking.
+func refundLineInputs(o *api.Order, specs []string, locationID string) ([]api.RefundLineItemInput, error) {
+	ids := make([]string, len(o.LineItems.Edges))
+	skus := make([]string, len(o.LineItems.Edges))
+	for i, e := range o.LineItems.Edges {
+		ids[i], skus[i] = e.Node.ID, e.Node.SKU
+	}
+	lines := make([]api.RefundLineItemInput, 0, len(specs))
+	for _, spec := range specs {
+		ref, qty, err := splitQuantitySpec(spec)
+		if err != nil {
+			return nil, fmt.Errorf("--line: %w", err)
+		}
+		i, err := matchLineRef(ref, o.Name, ids, skus)
+		if err != nil {
+			return nil, err
+		}
+		li := o.LineItems.Edges[i].Node
+		if qty == 0 || qty > li.RefundableQuantity {
+			return nil, fmt.Errorf("--line %q: quantity must be between 1 and %d (refundable)", spec, li.RefundableQuantity)
+		}
+		in := api.RefundLineItemInput{LineItemID: li.ID, Quantity: qty, RestockType: "NO_RESTOCK"}
+		if locationID != "" {
+			in.LocationID = locationID
+			in.RestockType = "RETURN"
+			if qty <= li.UnfulfilledQuantity {
+				in.RestockType = "CANCEL"
+			}
+		}
+		lines = append(lines, in)
+	}
+	return lines, nil
+}
+
+// refundTransactions builds refund transactions from Shopify's suggestion. With a
+// custom amount, it is spread over the suggested transactions up to what each can refund.
+func refundTransactions(s *api.SuggestedRefund, amount string) ([]api.RefundTransactionInput, error) {
+	var txs []api.RefundTransactionInput
+	if amount == "" {
+		for _, t := range s.SuggestedTransactions {
+			if t.ParentTransaction == nil || parseAmount(presentment(t.AmountSet).Amount) == 0 {
+				continue
+			}
+			txs = append(txs, api.RefundTransactionInput{
+				ParentID: t.ParentTransaction.ID,
+				Gateway:  t.Gateway,
+				Amount:   presentment(t.AmountSet).Amount,
+			})
+		}
+		return txs, nil
+	}
+	remaining := parseAmount(amount)
+	for _, t := range s.SuggestedTransactions {
+		if remaining <= 0 {
+			break
+		}
+		if t.ParentTransaction == nil {
+			continue
+		}
+		part := parseAmount(presentment(t.MaximumRefundableSet).Amount)
+		if part > remaining {
+			part = remaining
+		}
+		if part <= 0 {
+			continue
+		}
+		txs = append(txs, api.RefundTransactionInput{
+			ParentID: t.ParentTransaction.ID,
+			Gateway:  t.Gateway,
+			Amount:   strconv.FormatFloat(round2(part), 'f', 2, 64),
+		})
+		remaining = round2(remaining - part)
+	}
+	if remaining > 0 {
+		return nil, fmt.Errorf("--amount %s exceeds the refundable amount by %.2f", amount, remaining)
+	}
+	return txs, nil
+}
+
+// presentment returns the presentment (customer currency) amount of a MoneyBag,
+// falling back to the shop amount.
+func presentment(b api.MoneyBag) api.MoneyV2 {
+	if b.PresentmentMoney != nil {
+		return *b.PresentmentMoney
+	}
+	return b.ShopMoney
+}
+
+func transactionsTotal(txs []api.RefundTransactionInput, currency string) string {
+	total := 0.0
+	for _, t := range txs {
+		total += parseAmount(t.Amount)
+	}
+	return fmt.Sprintf("%.2f %s", total, currency)
+}
+
+// printSuggestedRefund prints the calculated refund and the transactions that will be issued.
+func printSuggestedRefund(orderName string, s *api.SuggestedRefund, txs []api.RefundTransactionInput, currency string) {
+	money := func(b api.MoneyBag) string {
+		return formatMoney(b.ShopMoney.Amount, b.ShopMoney.CurrencyCode)
+	}
+	fmt.Printf("Refund preview for %s:\n", orderName)
+	if len(s.RefundLineItems) > 0 {
+		headers := []string{"LINE", "TITLE", "SKU", "QTY", "RESTOCK", "SUBTOTAL"}
+		rows := make([][]string, len(s.RefundLineItems))
+		for i, li := range s.RefundLineItems {
+			rows[i] = []string{
+				shortID(li.LineItem.ID),
+				output.Truncate(li.LineItem.Title, 36),
+				li.LineItem.SKU,
+				fmt.Sprintf("%d", li.Quantity),
+				strings.ToLower(li.RestockType),
+				money(li.SubtotalSet),
+			}
+		}
+		output.PrintTable(headers, rows)
+		fmt.Println()
+	}
+	output.PrintKeyValue([][]string{
+		{"Subtotal", money(s.SubtotalSet)},
+		{"Tax", money(s.TotalTaxSet)},
+		{"Shipping", money(s.Shipping.AmountSet)},
+		{"Calculated refund", money(s.AmountSet)},
+		{"Max refundable", money(s.MaximumRefundableSet)},
+		{"To refund", transactionsTotal(txs, currency)},
+	})
+	if len(txs) > 0 {
+		fmt.Println()
+		fmt.Println("Transactions:")
+		headers := []string{"GATEWAY", "AMOUNT", "PARENT"}
+		rows := make([][]string, len(txs))
+		for i, t := range txs {
+			rows[i] = []string{t.Gateway, t.Amount + " " + currency, shortID(t.ParentID)}
+		}
+		output.PrintTable(headers, rows)
+	}
+}
+
+// ---- orders refunds ----
+
+var ordersRefundsCmd = &cobra.Command{
+	Use:   "refunds <id>",
+	Short: "List refunds issued on an order",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := resolveID("Order", args[0])
+		if err != nil {
+			return err
+		}
+		refunds, err := client.ListRefunds(id)
+		if err != nil {
+			return err
+		}
+		if output.IsJSON(cmd) {
+			return output.PrintJSON(refunds, output.IsPretty(cmd))
+		}
+		if len(refunds) == 0 {
+			fmt.Println("No refunds found.")
+			return nil
+		}
+		headers := []string{"ID", "CREATED", "AMOUNT", "ITEMS", "GATEWAYS", "NOTE"}
+		rows := make([][]string, len(refunds))
+		for i, r := range refunds {
+			items := make([]string, 0, len(r.RefundLineItems.Edges))
+			for _, e := range r.RefundLineItems.Edges {
+				label := e.Node.LineItem.SKU
+				if label == "" {
+					label = e.Node.LineItem.Title
+				}
+				items = append(items, fmt.Sprintf("%d× %s", e.Node.Quantity, label))
+			}
+			gateways := make([]string, 0, len(r.Transactions.Edges))
+			for _, e := range r.Transactions.Edges {
+				gateways = append(gateways, e.Node.Gateway)
+			}
+			rows[i] = []string{
+				shortID(r.ID),
+				output.FormatTime(r.CreatedAt),
+				formatMoney(r.TotalRefundedSet.ShopMoney.Amount, r.TotalRefundedSet.ShopMoney.CurrencyCode),
+				output.Truncate(orDash(strings.Join(items, ", ")), 40),
+				orDash(strings.Join(gateways, ", ")),
+				output.Truncate(orDash(r.Note), 30),
+			}
+		}
+		output.PrintTable(headers, rows)
+		return nil
+	},
+}
+
+func init() {
+	ordersRefundCmd.Flags().StringArrayVar(&orderRefundLines, "line", nil, "Refund a line: <line>:<qty> (repeatable)")
+	ordersRefundCmd.Flags().StringVar(&orderRefundShipping, "shipping", "", "Refund shipping: full or an amount")
+	ordersRefundCmd.Flags().StringVar(&orderRefundAmount, "amount", "", "Refund this amount instead of the calculated total")
+	ordersRefundCmd.Flags().StringVar(&orderRefundRestockLocation, "restock-location", "", "Restock refunded items at this location ID")
+	ordersRefundCmd.Flags().StringVar(&orderRefundNote, "note", "", "Reason for the refund")
+	ordersRefundCmd.Flags().BoolVar(&orderRefundNotify, "notify", false, "Email the customer a refund notification")
+	ordersRefundCmd.Flags().BoolVar(&orderRefundDryRun, "dry-run", false, "Show the calculated refund without creating it")
+	ordersRefundCmd.Flags().BoolVar(&orderRefundYes, "yes", false, "Create the refund without asking for confirmation")
+
+	ordersCmd.AddCommand(ordersRefundCmd, ordersRefundsCmd)
+}
@@ -0,0 +1,201 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/the20100/shopify-admin-cli/internal/api"
+	"github.com/the20100/shopify-admin-cli/internal/output"
+)
+
+// ---- orders risky ----
+
+var (
+	orderRiskySince string
+	orderRiskyLevel string
+	orderRiskyQuery string
+)
+
+// riskLevelRank orders RiskAssessmentResult values; PENDING and NONE rank lowest.
+var riskLevelRank = map[string]int{"LOW": 1, "MEDIUM": 2, "HIGH": 3}
+
+type riskyOrder struct {
+	Order          string   `json:"order"`
+	OrderID        string   `json:"orderId"`
+	CreatedAt      string   `json:"createdAt"`
+	Customer       string   `json:"customer"`
+	Email          string   `json:"email"`
+	Total          string   `json:"total"`
+	Currency       string   `json:"currency"`
+	Financial      string   `json:"financialStatus"`
+	Fulfillment    string   `json:"fulfillmentStatus"`
+	RiskLevel      string   `json:"riskLevel"`
+	Recommendation string   `json:"recommendation"`
+	Facts          []string `json:"facts"`
+}
+
+var ordersRiskyCmd = &cobra.Command{
+	Use:   "risky",
+	Short: "List recent orders flagged as medium or high risk",
+	Long: `List orders created since a date whose fraud analysis is at or above a risk
+level, newest first, with the facts that drove the assessment (negative ones
+first). Meant for a fraud review queue, e.g. from cron with --json.
+
+--since takes a date (2024-06-01) or a relative period (-3d, -2m, -1y).
+
+Examples:
+  shopify-admin orders risky --since -3d
+  shopify-admin orders risky --since -1d --level high --json
+  shopify-admin orders risky --since 2024-06-01 --query "fulfillment_status:unfulfilled"`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		minRank, ok := riskLevelRank[strings.ToUpper(orderRiskyLevel)]
+		if !ok {
+			return fmt.Errorf("invalid --level %q: expected low, medium, or high", orderRiskyLevel)
+		}
+		since, err := parseSince("--since", orderRiskySince)
+		if err != nil {
+			return err
+		}
+		query := "created_at:>='" + since.Format(time.RFC3339) + "'"
+		if orderRiskyQuery != "" {
+			query += " " + orderRiskyQuery
+		}
+
+		var report []riskyOrder
+		after := ""
+		for {
+			conn, err := client.ListOrdersWithRisk(100, after, query)
+			if err != nil {
+				return err
+			}
+			for _, e := range conn.Edges {
+				o := e.Node
+				level := highestRiskLevel(o.Risk)
+				if riskLevelRank[level] < minRank {
+					continue
+				}
+				customer := ""
+				if o.Customer != nil {
+					customer = strings.TrimSpace(o.Customer.FirstName + " " + o.Customer.LastName)
+				}
+				report = append(report, riskyOrder{
+					Order:          o.Name,
+					OrderID:        shortID(o.ID),
+					CreatedAt:      o.CreatedAt,
+					Customer:       customer,
+					Email:          o.Email,
+					Total:          o.TotalPriceSet.ShopMoney.Amount,
+					Currency:       o.TotalPriceSet.ShopMoney.CurrencyCode,
+					Financial:      strings.ToLower(o.FinancialStatus),
+					Fulfillment:    strings.ToLower(o.DisplayFulfillmentStatus),
+					RiskLevel:      strings.ToLower(level),
+					Recommendation: strings.ToLower(o.Risk.Recommendation),
+					Facts:          topRiskFacts(o.Risk, 3),
+				})
+			}
+			if !conn.PageInfo.HasNextPage {
+				break
+			}
+			after = conn.PageInfo.EndCursor
+		}
+
+		if output.IsJSON(cmd) {
+			if report == nil {
+				report = []riskyOrder{}
+			}
+			return output.PrintJSON(report, output.IsPretty(cmd))
+		}
+		if len(report) == 0 {
+			fmt.Printf("No %s-risk orders since %s.\n", strings.ToLower(orderRiskyLevel), since.Format("2006-01-02"))
+			return nil
+		}
+		headers := []string{"ORDER", "ID", "CREATED", "RISK", "RECOMMENDATION", "TOTAL", "CUSTOMER", "TOP FACTS"}
+		rows := make([][]string, len(report))
+		for i, r := range report {
+			rows[i] = []string{
+				r.Order,
+				r.OrderID,
+				output.FormatTime(r.CreatedAt),
+				r.RiskLevel,
+				orDash(r.Recommendation),
+				formatMoney(r.Total, r.Currency),
+				output.Truncate(orDash(r.Customer), 20),
+				output.Truncate(strings.Join(r.Facts, "; "), 60),
+			}
+		}
+		output.PrintTable(headers, rows)
+		return nil
+	},
+}
+
+// highestRiskLevel returns the most severe riskLevel across all assessments.
+func highestRiskLevel(r *api.OrderRisk) string {
+	level := "NONE"
+	if r == nil {
+		return level
+	}
+	for _, a := range r.Assessments {
+		if riskLevelRank[a.RiskLevel] > riskLevelRank[level] {
+			level = a.RiskLevel
+		}
+	}
+	return level
+}
+
+// topRiskFacts returns up to n fact descriptions, negative sentiment first.
+func topRiskFacts(r *api.OrderRisk, n int) []string {
+	facts := []string{}
+	if r == nil {
+		return facts
+	}
+	for _, sentiment := range []string{"NEGATIVE", "NEUTRAL", "POSITIVE"} {
+		for _, a := range r.Assessments {
+			for _, f := range a.Facts {
+				if f.Sentiment == sentiment && len(facts) < n {
+					facts = append(facts, f.Description)
+				}
+			}
+		}
+	}
+	return facts
+}
+
+// printOrderRisk prints an order's risk recommendation and each provider's assessment.
+func printOrderRisk(r *api.OrderRisk) {
+	if r == nil || len(r.Assessments) == 0 {
+		return
+	}
+	fmt.Println()
+	fmt.Printf("Risk: %s (recommendation: %s)\n",
+		strings.ToLower(highestRiskLevel(r)), orDash(strings.ToLower(r.Recommendation)))
+	headers := []string{"PROVIDER", "LEVEL", "SENTIMENT", "FACT"}
+	var rows [][]string
+	for _, a := range r.Assessments {
+		provider := "Shopify"
+		if a.Provider != nil {
+			provider = a.Provider.Title
+		}
+		if len(a.Facts) == 0 {
+			rows = append(rows, []string{provider, strings.ToLower(a.RiskLevel), "-", "-"})
+		}
+		for _, f := range a.Facts {
+			rows = append(rows, []string{
+				provider,
+				strings.ToLower(a.RiskLevel),
+				strings.ToLower(f.Sentiment),
+				output.Truncate(f.Description, 70),
+			})
+		}
+	}
+	output.PrintTable(headers, rows)
+}
+
+func init() {
+	ordersRiskyCmd.Flags().StringVar(&orderRiskySince, "since", "-3d", "Only orders created on or after this date or relative period")
+	ordersRiskyCmd.Flags().StringVar(&orderRiskyLevel, "level", "medium", "Minimum risk level: low, medium, high")
+	ordersRiskyCmd.Flags().StringVar(&orderRiskyQuery, "query", "", "Additional Shopify search query")
+
+	ordersCmd.AddCommand(ordersRiskyCmd)
+}
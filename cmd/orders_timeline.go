@@ -0,0 +1,219 @@
+package cmd
+
+import (
+	"fmt"
+	"html"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/the20100/shopify-admin-cli/internal/api"
+	"github.com/the20100/shopify-admin-cli/internal/output"
+)
+
+// ---- orders timeline ----
+
+var orderTimelineTypes []string
+
+type timelineEntry struct {
+	Time    string `json:"time"`
+	Type    string `json:"type"`
+	Source  string `json:"source"`
+	Summary string `json:"summary"`
+	ID      string `json:"id,omitempty"`
+}
+
+var ordersTimelineCmd = &cobra.Command{
+	Use:   "timeline <id>",
+	Short: "Show everything that happened to an order, oldest first",
+	Long: `Show one chronological view of an order: its event log (financial, fulfillment,
+edit, and staff comment events) merged with fulfillments, deliveries, refunds,
+and payment transactions.
+
+Entry types: order, event, comment, fulfillment, delivery, refund, transaction.
+
+Examples:
+  shopify-admin orders timeline "#1001"
+  shopify-admin orders timeline 1234567890 --type comment,refund
+  shopify-admin orders timeline "#1001" --json`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := resolveID("Order", args[0])
+		if err != nil {
+			return err
+		}
+		t, err := client.GetOrderTimeline(id)
+		if err != nil {
+			return err
+		}
+		entries := buildTimeline(t)
+		if len(orderTimelineTypes) > 0 {
+			keep := map[string]bool{}
+			for _, typ := range orderTimelineTypes {
+				keep[strings.ToLower(strings.TrimSpace(typ))] = true
+			}
+			filtered := entries[:0]
+			for _, e := range entries {
+				if keep[e.Type] {
+					filtered = append(filtered, e)
+				}
+			}
+			entries = filtered
+		}
+
+		if output.IsJSON(cmd) {
+			return output.PrintJSON(entries, output.IsPretty(cmd))
+		}
+		if len(entries) == 0 {
+			fmt.Printf("No timeline entries found on %s.\n", t.Name)
+			return nil
+		}
+		headers := []string{"TIME", "TYPE", "SOURCE", "DETAILS"}
+		rows := make([][]string, len(entries))
+		for i, e := range entries {
+			rows[i] = []string{
+				output.FormatTime(e.Time),
+				e.Type,
+				output.Truncate(e.Source, 20),
+				output.Truncate(e.Summary, 90),
+			}
+		}
+		output.PrintTable(headers, rows)
+		if t.Note != "" {
+			fmt.Println()
+			fmt.Println("Note:")
+			fmt.Println(t.Note)
+		}
+		if t.Events.PageInfo.HasNextPage {
+			fmt.Println("\n(only the first 250 events are shown)")
+		}
+		return nil
+	},
+}
+
+var htmlTagRe = regexp.MustCompile(`<[^>]*>`)
+
+// plainText strips HTML tags and entities from an event message.
+func plainText(s string) string {
+	return strings.Join(strings.Fields(html.UnescapeString(htmlTagRe.ReplaceAllString(s, ""))), " ")
+}
+
+// buildTimeline merges events, fulfillments, refunds, and transactions by time.
+func buildTimeline(t *api.OrderTimeline) []timelineEntry {
+	entries := []timelineEntry{{Time: t.CreatedAt, Type: "order", Source: "Shopify", Summary: "Order " + t.Name + " placed"}}
+	if t.CancelledAt != "" {
+		entries = append(entries, timelineEntry{Time: t.CancelledAt, Type: "order", Source: "Shopify",
+			Summary: "Order cancelled (" + strings.ToLower(orDash(t.CancelReason)) + ")"})
+	}
+	if t.ClosedAt != "" {
+		entries = append(entries, timelineEntry{Time: t.ClosedAt, Type: "order", Source: "Shopify", Summary: "Order closed"})
+	}
+	for _, e := range t.Events.Edges {
+		ev := e.Node
+		entry := timelineEntry{Time: ev.CreatedAt, Type: "event", Source: "Shopify", Summary: plainText(ev.Message), ID: shortID(ev.ID)}
+		switch {
+		case ev.Author != nil:
+			entry.Type = "comment"
+			entry.Source = ev.Author.Name
+			if ev.RawMessage != "" {
+				entry.Summary = plainText(ev.RawMessage)
+			}
+		case ev.AttributeToApp && ev.AppTitle != "":
+			entry.Source = ev.AppTitle
+		case ev.AttributeToUser:
+			entry.Source = "staff"
+		}
+		if ev.CriticalAlert {
+			entry.Summary = "[!] " + entry.Summary
+		}
+		entries = append(entries, entry)
+	}
+	for _, f := range t.Fulfillments {
+		var tracking []string
+		for _, ti := range f.TrackingInfo {
+			tracking = append(tracking, strings.TrimSpace(ti.Company+" "+ti.Number))
+		}
+		summary := fmt.Sprintf("Fulfillment %s %s", f.Name, strings.ToLower(orDash(f.DisplayStatus)))
+		if f.Location != nil {
+			summary += " from " + f.Location.Name
+		}
+		if len(tracking) > 0 {
+			summary += ", tracking " + strings.Join(tracking, ", ")
+		}
+		entries = append(entries, timelineEntry{Time: f.CreatedAt, Type: "fulfillment", Source: "Shopify", Summary: summary, ID: shortID(f.ID)})
+		if f.DeliveredAt != "" {
+			entries = append(entries, timelineEntry{Time: f.DeliveredAt, Type: "delivery", Source: "carrier",
+				Summary: "Fulfillment " + f.Name + " delivered", ID: shortID(f.ID)})
+		}
+	}
+	for _, r := range t.Refunds {
+		summary := "Refunded " + formatMoney(r.TotalRefundedSet.ShopMoney.Amount, r.TotalRefundedSet.ShopMoney.CurrencyCode)
+		if r.Note != "" {
+			summary += ": " + r.Note
+		}
+		entries = append(entries, timelineEntry{Time: r.CreatedAt, Type: "refund", Source: "Shopify", Summary: summary, ID: shortID(r.ID)})
+	}
+	for _, tx := range t.Transactions {
+		summary := fmt.Sprintf("%s %s %s", strings.ToLower(tx.Kind),
+			formatMoney(tx.AmountSet.ShopMoney.Amount, tx.AmountSet.ShopMoney.CurrencyCode), strings.ToLower(tx.Status))
+		if tx.ErrorCode != "" {
+			summary += " (" + strings.ToLower(tx.ErrorCode) + ")"
+		}
+		entries = append(entries, timelineEntry{Time: tx.CreatedAt, Type: "transaction", Source: tx.Gateway, Summary: summary, ID: shortID(tx.ID)})
+	}
+	sort.SliceStable(entries, func(i, j int) bool { return entries[i].Time < entries[j].Time })
+	return entries
+}
+
+// ---- orders comment ----
+
+var ordersCommentCmd = &cobra.Command{
+	Use:   "comment <id> <text>",
+	Short: "Add a timestamped staff comment to an order's note",
+	Long: `Add a staff comment to an order.
+
+The Admin API has no mutation for posting timeline comments, so the comment is
+appended to the order note as a new "[YYYY-MM-DD HH:MM] text" line. It is shown
+at the bottom of 'orders timeline' and in the note field in the Shopify admin.
+
+Examples:
+  shopify-admin orders comment "#1001" "Customer called: wants delivery after 5pm"
+  shopify-admin orders comment 1234567890 "Replacement sent, see #1042"`,
+	Args: cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		text := strings.TrimSpace(args[1])
+		if text == "" {
+			return fmt.Errorf("comment text is empty")
+		}
+		id, err := resolveID("Order", args[0])
+		if err != nil {
+			return err
+		}
+		current, err := client.GetOrder(id)
+		if err != nil {
+			return err
+		}
+		line := fmt.Sprintf("[%s] %s", time.Now().Format("2006-01-02 15:04"), text)
+		note := line
+		if current.Note != "" {
+			note = strings.TrimRight(current.Note, "\n") + "\n" + line
+		}
+		o, err := client.UpdateOrder(id, map[string]any{"note": note})
+		if err != nil {
+			return err
+		}
+		if output.IsJSON(cmd) {
+			return output.PrintJSON(o, output.IsPretty(cmd))
+		}
+		fmt.Printf("Comment added to the note on %s.\n", o.Name)
+		return nil
+	},
+}
+
+func init() {
+	ordersTimelineCmd.Flags().StringSliceVar(&orderTimelineTypes, "type", nil, "Only show these entry types (comma-separated)")
+
+	ordersCmd.AddCommand(ordersTimelineCmd, ordersCommentCmd)
+}
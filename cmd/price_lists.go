@@ -0,0 +1,316 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/the20100/shopify-admin-cli/internal/api"
+	"github.com/the20100/shopify-admin-cli/internal/output"
+)
+
+var priceListsCmd = &cobra.Command{
+	Use:   "price-lists",
+	Short: "Manage market price lists and fixed international prices",
+}
+
+// describeAdjustment formats a price list's percentage adjustment as e.g. "-10%".
+func describeAdjustment(pl api.PriceList) string {
+	if pl.Parent == nil {
+		return "-"
+	}
+	adj := pl.Parent.Adjustment
+	sign := "+"
+	if adj.Type == "PERCENTAGE_DECREASE" {
+		sign = "-"
+	}
+	return sign + strconv.FormatFloat(adj.Value, 'f', -1, 64) + "%"
+}
+
+func catalogTitle(pl api.PriceList) string {
+	if pl.Catalog == nil {
+		return "-"
+	}
+	return pl.Catalog.Title
+}
+
+// ---- price-lists list ----
+
+var (
+	priceListsListFirst int
+	priceListsListAfter string
+)
+
+var priceListsListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List price lists",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		conn, err := client.ListPriceLists(priceListsListFirst, priceListsListAfter)
+		if err != nil {
+			return err
+		}
+		if output.IsJSON(cmd) {
+			items := make([]any, len(conn.Edges))
+			for i, e := range conn.Edges {
+				items[i] = e.Node
+			}
+			return output.PrintJSON(items, output.IsPretty(cmd))
+		}
+		if len(conn.Edges) == 0 {
+			fmt.Println("No price lists found.")
+			return nil
+		}
+		headers := []string{"ID", "NAME", "CURRENCY", "ADJUSTMENT", "FIXED PRICES", "CATALOG"}
+		rows := make([][]string, len(conn.Edges))
+		for i, e := range conn.Edges {
+			pl := e.Node
+			rows[i] = []string{
+				shortID(pl.ID),
+				output.Truncate(pl.Name, 36),
+				pl.Currency,
+				describeAdjustment(pl),
+				fmt.Sprintf("%d", pl.FixedPricesCount),
+				output.Truncate(catalogTitle(pl), 30),
+			}
+		}
+		output.PrintTable(headers, rows)
+		if conn.PageInfo.HasNextPage {
+			fmt.Printf("\n(more results — use --after %s)\n", conn.PageInfo.EndCursor)
+		}
+		return nil
+	},
+}
+
+// ---- price-lists get ----
+
+var (
+	priceListsGetFirst int
+	priceListsGetAfter string
+)
+
+var priceListsGetCmd = &cobra.Command{
+	Use:   "get <id>",
+	Short: "Get a price list and its fixed prices",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := resolveID("PriceList", args[0])
+		if err != nil {
+			return err
+		}
+		pl, err := client.GetPriceList(id, priceListsGetFirst, priceListsGetAfter)
+		if err != nil {
+			return err
+		}
+		if output.IsJSON(cmd) {
+			return output.PrintJSON(pl, output.IsPretty(cmd))
+		}
+		output.PrintKeyValue([][]string{
+			{"ID", shortID(pl.ID)},
+			{"Name", pl.Name},
+			{"Currency", pl.Currency},
+			{"Adjustment", describeAdjustment(*pl)},
+			{"Fixed Prices", fmt.Sprintf("%d", pl.FixedPricesCount)},
+			{"Catalog", catalogTitle(*pl)},
+		})
+		if len(pl.Prices.Edges) > 0 {
+			fmt.Println()
+			fmt.Println("Fixed prices:")
+			headers := []string{"VARIANT", "SKU", "TITLE", "PRICE", "COMPARE AT"}
+			rows := make([][]string, len(pl.Prices.Edges))
+			for i, e := range pl.Prices.Edges {
+				p := e.Node
+				compareAt := "-"
+				if p.CompareAtPrice != nil {
+					compareAt = formatMoney(p.CompareAtPrice.Amount, p.CompareAtPrice.CurrencyCode)
+				}
+				rows[i] = []string{
+					shortID(p.Variant.ID),
+					p.Variant.SKU,
+					output.Truncate(p.Variant.DisplayName, 40),
+					formatMoney(p.Price.Amount, p.Price.CurrencyCode),
+					compareAt,
+				}
+			}
+			output.PrintTable(headers, rows)
+			if pl.Prices.PageInfo.HasNextPage {
+				fmt.Printf("\n(more results — use --after %s)\n", pl.Prices.PageInfo.EndCursor)
+			}
+		}
+		return nil
+	},
+}
+
+// ---- price-lists create ----
+
+var (
+	priceListCreateName     string
+	priceListCreateCurrency string
+	priceListCreateAdjust   float64
+	priceListCreateCatalog  string
+)
+
+var priceListsCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create a price list",
+	Long: `Create a price list in a currency. --adjust sets a percentage adjustment relative
+to base prices for every variant without a fixed price (negative = discount).
+
+Attach it to a market catalog with --catalog, or later in the Shopify admin.
+
+Examples:
+  shopify-admin price-lists create --name "EU prices" --currency EUR
+  shopify-admin price-lists create --name "UK -10%" --currency GBP --adjust -10
+  shopify-admin price-lists create --name "CH" --currency CHF --adjust 15 --catalog 12345`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if priceListCreateName == "" {
+			return fmt.Errorf("--name is required")
+		}
+		if priceListCreateCurrency == "" {
+			return fmt.Errorf("--currency is required")
+		}
+		pl, err := client.CreatePriceList(
+			priceListCreateName,
+			strings.ToUpper(priceListCreateCurrency),
+			priceListCreateAdjust,
+			priceListCreateCatalog,
+		)
+		if err != nil {
+			return err
+		}
+		if output.IsJSON(cmd) {
+			return output.PrintJSON(pl, output.IsPretty(cmd))
+		}
+		fmt.Printf("Price list created: %s\n", pl.Name)
+		fmt.Printf("ID:         %s\n", shortID(pl.ID))
+		fmt.Printf("Currency:   %s\n", pl.Currency)
+		fmt.Printf("Adjustment: %s\n", describeAdjustment(*pl))
+		return nil
+	},
+}
+
+// ---- price-lists set-prices ----
+
+var (
+	priceListSetPricesFile   string
+	priceListSetPricesDryRun bool
+)
+
+// priceListBatchSize is the maximum number of prices per fixed-price mutation.
+const priceListBatchSize = 250
+
+var priceListsSetPricesCmd = &cobra.Command{
+	Use:   "set-prices <id>",
+	Short: "Set or remove fixed prices from a CSV file",
+	Long: `Set fixed prices on a price list from a CSV file. Prices are in the price list currency.
+
+Columns: variant, price, compare_at_price (optional)
+The variant column accepts variant IDs, sku:..., or barcode:... references.
+A "sku" column may be used instead of "variant".
+Rows with an empty price remove the variant's fixed price, so it falls back to
+the price list's percentage adjustment.
+
+Examples:
+  shopify-admin price-lists set-prices 12345 --file prices-eur.csv
+  shopify-admin price-lists set-prices 12345 --file prices-eur.csv --dry-run`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if priceListSetPricesFile == "" {
+			return fmt.Errorf("--file is required")
+		}
+		records, err := readCSVFile(priceListSetPricesFile)
+		if err != nil {
+			return err
+		}
+		variantCol := "variant"
+		if len(records) > 0 {
+			if _, ok := records[0]["variant"]; !ok {
+				variantCol = "sku"
+			}
+		}
+		if err := requireColumns(records, priceListSetPricesFile, variantCol, "price"); err != nil {
+			return err
+		}
+		id, err := resolveID("PriceList", args[0])
+		if err != nil {
+			return err
+		}
+		pl, err := client.GetPriceList(id, 1, "")
+		if err != nil {
+			return err
+		}
+
+		var adds []api.PriceListFixedPrice
+		var deletes []string
+		for i, rec := range records {
+			ref := rec[variantCol]
+			if variantCol == "sku" {
+				ref = "sku:" + ref
+			}
+			variantID, err := resolveID("ProductVariant", ref)
+			if err != nil {
+				return fmt.Errorf("%s row %d: %w", priceListSetPricesFile, i+2, err)
+			}
+			if rec["price"] == "" {
+				deletes = append(deletes, variantID)
+				continue
+			}
+			adds = append(adds, api.PriceListFixedPrice{
+				VariantID:      variantID,
+				Price:          rec["price"],
+				CompareAtPrice: rec["compare_at_price"],
+			})
+		}
+
+		if priceListSetPricesDryRun {
+			fmt.Printf("Dry run: would set %d and remove %d fixed prices on %s (%s).\n",
+				len(adds), len(deletes), pl.Name, pl.Currency)
+			return nil
+		}
+		for start := 0; start < len(adds); start += priceListBatchSize {
+			end := min(start+priceListBatchSize, len(adds))
+			if err := client.AddPriceListFixedPrices(pl.ID, pl.Currency, adds[start:end]); err != nil {
+				return fmt.Errorf("setting prices %d-%d: %w", start+1, end, err)
+			}
+		}
+		for start := 0; start < len(deletes); start += priceListBatchSize {
+			end := min(start+priceListBatchSize, len(deletes))
+			if err := client.DeletePriceListFixedPrices(pl.ID, deletes[start:end]); err != nil {
+				return fmt.Errorf("removing prices %d-%d: %w", start+1, end, err)
+			}
+		}
+		if output.IsJSON(cmd) {
+			return output.PrintJSON(map[string]any{
+				"priceListId": pl.ID,
+				"set":         len(adds),
+				"removed":     len(deletes),
+			}, output.IsPretty(cmd))
+		}
+		fmt.Printf("Price list %s: %d fixed prices set, %d removed.\n", pl.Name, len(adds), len(deletes))
+		return nil
+	},
+}
+
+func init() {
+	priceListsListCmd.Flags().IntVar(&priceListsListFirst, "first", 50, "Number of price lists to return")
+	priceListsListCmd.Flags().StringVar(&priceListsListAfter, "after", "", "Pagination cursor")
+
+	priceListsGetCmd.Flags().IntVar(&priceListsGetFirst, "first", 50, "Number of fixed prices to return")
+	priceListsGetCmd.Flags().StringVar(&priceListsGetAfter, "after", "", "Pagination cursor for fixed prices")
+
+	priceListsCreateCmd.Flags().StringVar(&priceListCreateName, "name", "", "Price list name (required)")
+	priceListsCreateCmd.Flags().StringVar(&priceListCreateCurrency, "currency", "", "Currency code, e.g. EUR (required)")
+	priceListsCreateCmd.Flags().Float64Var(&priceListCreateAdjust, "adjust", 0, "Percentage adjustment relative to base prices (e.g. -10 or 5)")
+	priceListsCreateCmd.Flags().StringVar(&priceListCreateCatalog, "catalog", "", "Market catalog ID to attach the price list to")
+
+	priceListsSetPricesCmd.Flags().StringVar(&priceListSetPricesFile, "file", "", "CSV file with variant, price, compare_at_price columns (required)")
+	priceListsSetPricesCmd.Flags().BoolVar(&priceListSetPricesDryRun, "dry-run", false, "Resolve variants and show what would change")
+
+	priceListsCmd.AddCommand(
+		priceListsListCmd,
+		priceListsGetCmd,
+		priceListsCreateCmd,
+		priceListsSetPricesCmd,
+	)
+	rootCmd.AddCommand(priceListsCmd)
+}
@@ -77,7 +77,11 @@ var productsGetCmd = &cobra.Command{
 	Short: "Get details of a specific product",
 	Args:  cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
-		p, err := client.GetProduct(args[0])
+		id, err := resolveID("Product", args[0])
+		if err != nil {
+			return err
+		}
+		p, err := client.GetProduct(id)
 		if err != nil {
 			return err
 		}
@@ -113,6 +117,73 @@ var productsGetCmd = &cobra.Command{
 			}
 			output.PrintTable(headers, rows)
 		}
+		if len(p.SellingPlanGroups.Edges) > 0 {
+			fmt.Println()
+			fmt.Println("Selling plan groups:")
+			headers := []string{"ID", "NAME", "SUMMARY"}
+			rows := make([][]string, len(p.SellingPlanGroups.Edges))
+			for i, e := range p.SellingPlanGroups.Edges {
+				g := e.Node
+				rows[i] = []string{shortID(g.ID), g.Name, output.Truncate(g.Summary, 50)}
+			}
+			output.PrintTable(headers, rows)
+		}
+		return nil
+	},
+}
+
+// ---- products price ----
+
+var productPriceCountry string
+
+var productsPriceCmd = &cobra.Command{
+	Use:   "price <id>",
+	Short: "Show the variant prices a buyer in a country sees",
+	Long: `Show contextual prices for every variant of a product, as seen by a buyer in --country.
+Includes price list adjustments and fixed prices for the market covering that country.
+
+Examples:
+  shopify-admin products price 1234567890 --country DE
+  shopify-admin products price handle:blue-shirt --country JP --json`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if productPriceCountry == "" {
+			return fmt.Errorf("--country is required")
+		}
+		id, err := resolveID("Product", args[0])
+		if err != nil {
+			return err
+		}
+		country := strings.ToUpper(productPriceCountry)
+		prices, err := client.GetContextualPrices(id, country)
+		if err != nil {
+			return err
+		}
+		if output.IsJSON(cmd) {
+			return output.PrintJSON(prices, output.IsPretty(cmd))
+		}
+		if len(prices) == 0 {
+			fmt.Println("No variants found.")
+			return nil
+		}
+		headers := []string{"ID", "TITLE", "SKU", "BASE PRICE", "PRICE (" + country + ")", "COMPARE AT"}
+		rows := make([][]string, len(prices))
+		for i, v := range prices {
+			cp := v.ContextualPricing
+			compareAt := "-"
+			if cp.CompareAtPrice != nil {
+				compareAt = formatMoney(cp.CompareAtPrice.Amount, cp.CompareAtPrice.CurrencyCode)
+			}
+			rows[i] = []string{
+				shortID(v.ID),
+				output.Truncate(v.Title, 30),
+				v.SKU,
+				v.Price,
+				formatMoney(cp.Price.Amount, cp.Price.CurrencyCode),
+				compareAt,
+			}
+		}
+		output.PrintTable(headers, rows)
 		return nil
 	},
 }
@@ -180,8 +251,12 @@ Examples:
   shopify-admin products update 1234567890 --title "New Title" --vendor "New Vendor"`,
 	Args: cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := resolveID("Product", args[0])
+		if err != nil {
+			return err
+		}
 		p, err := client.UpdateProduct(
-			args[0],
+			id,
 			productUpdateTitle,
 			productUpdateVendor,
 			productUpdateType,
@@ -209,7 +284,11 @@ var productsDeleteCmd = &cobra.Command{
 	Short: "Delete a product (irreversible)",
 	Args:  cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
-		if err := client.DeleteProduct(args[0]); err != nil {
+		id, err := resolveID("Product", args[0])
+		if err != nil {
+			return err
+		}
+		if err := client.DeleteProduct(id); err != nil {
 			return err
 		}
 		fmt.Printf("Product %s deleted.\n", args[0])
@@ -229,7 +308,11 @@ var variantsGetCmd = &cobra.Command{
 	Short: "Get details of a specific variant",
 	Args:  cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
-		v, err := client.GetVariant(args[0])
+		id, err := resolveID("ProductVariant", args[0])
+		if err != nil {
+			return err
+		}
+		v, err := client.GetVariant(id)
 		if err != nil {
 			return err
 		}
@@ -267,7 +350,11 @@ Examples:
   shopify-admin products variants update 1234567890 --sku MY-SKU-001`,
 	Args: cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
-		v, err := client.UpdateVariant(args[0], variantUpdatePrice, variantUpdateSKU, variantUpdateBarcode)
+		id, err := resolveID("ProductVariant", args[0])
+		if err != nil {
+			return err
+		}
+		v, err := client.UpdateVariant(id, variantUpdatePrice, variantUpdateSKU, variantUpdateBarcode)
 		if err != nil {
 			return err
 		}
@@ -303,6 +390,8 @@ func init() {
 	productsUpdateCmd.Flags().StringVar(&productUpdateDescription, "desc", "", "New HTML description")
 	productsUpdateCmd.Flags().StringVar(&productUpdateTags, "tags", "", "New comma-separated tags")
 
+	productsPriceCmd.Flags().StringVar(&productPriceCountry, "country", "", "Buyer country code, e.g. DE (required)")
+
 	// variants update
 	variantsUpdateCmd.Flags().StringVar(&variantUpdatePrice, "price", "", "New price (e.g. 29.99)")
 	variantsUpdateCmd.Flags().StringVar(&variantUpdateSKU, "sku", "", "New SKU")
@@ -312,6 +401,7 @@ func init() {
 	productsCmd.AddCommand(
 		productsListCmd,
 		productsGetCmd,
+		productsPriceCmd,
 		productsCreateCmd,
 		productsUpdateCmd,
 		productsDeleteCmd,
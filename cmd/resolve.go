@@ -0,0 +1,76 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/the20100/shopify-admin-cli/internal/api"
+	"github.com/the20100/shopify-admin-cli/internal/output"
+)
+
+var (
+	resolveType   string
+	resolveIDOnly bool
+)
+
+var resolveCmd = &cobra.Command{
+	Use:   "resolve <ref>",
+	Short: "Resolve a SKU, handle, barcode, order name, or email to a GID",
+	Long: `Resolve a flexible reference to a Shopify GID.
+
+Every command that takes an <id> accepts the same reference forms:
+  12345                numeric ID (needs --type here)
+  gid://shopify/...    GID, returned unchanged if it matches the type
+  sku:ABC-1            variant SKU        → ProductVariant (or Product, InventoryItem with --type)
+  barcode:012345       variant barcode    → ProductVariant (or Product, InventoryItem with --type)
+  handle:blue-shirt    handle             → Product (or Collection with --type)
+  #1001                order name         → Order
+  #D12                 draft order name   → DraftOrder
+  jane@example.com     customer email     → Customer
+
+A reference matching more than one resource is an error.
+
+Examples:
+  shopify-admin resolve sku:TSHIRT-BLUE-M
+  shopify-admin resolve sku:TSHIRT-BLUE-M --type InventoryItem
+  shopify-admin resolve handle:summer --type Collection
+  shopify-admin resolve "#1001" --id-only`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ref := args[0]
+		resourceType := resolveType
+		if resourceType == "" {
+			resourceType = api.InferRefType(ref)
+		}
+		if resourceType == "" {
+			return fmt.Errorf("cannot infer the resource type of %q — pass --type (e.g. Product, Order)", ref)
+		}
+		id, err := resolveID(resourceType, ref)
+		if err != nil {
+			return err
+		}
+		if resolveIDOnly {
+			fmt.Println(id)
+			return nil
+		}
+		if output.IsJSON(cmd) {
+			return output.PrintJSON(map[string]string{
+				"ref":  ref,
+				"type": resourceType,
+				"id":   id,
+			}, output.IsPretty(cmd))
+		}
+		output.PrintKeyValue([][]string{
+			{"Ref", ref},
+			{"Type", resourceType},
+			{"ID", id},
+		})
+		return nil
+	},
+}
+
+func init() {
+	resolveCmd.Flags().StringVar(&resolveType, "type", "", "Resource type to resolve to (e.g. Product, ProductVariant, InventoryItem, Collection)")
+	resolveCmd.Flags().BoolVar(&resolveIDOnly, "id-only", false, "Print only the GID")
+	rootCmd.AddCommand(resolveCmd)
+}
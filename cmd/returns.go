@@ -0,0 +1,611 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/the20100/shopify-admin-cli/internal/api"
+	"github.com/the20100/shopify-admin-cli/internal/output"
+)
+
+var returnsCmd = &cobra.Command{
+	Use:   "returns",
+	Short: "Manage customer returns",
+}
+
+// ---- returns list ----
+
+var (
+	returnsListStatus string
+	returnsListOrder  string
+	returnsListFirst  int
+	returnsListAfter  string
+)
+
+// returnStatusQueries maps --status to the order search filter that finds orders
+// with such returns; returns are then filtered by their own status.
+var returnStatusQueries = map[string]string{
+	"requested": "return_status:return_requested",
+	"open":      "return_status:in_progress",
+	"closed":    "return_status:returned",
+	"declined":  "-return_status:no_return",
+	"canceled":  "-return_status:no_return",
+	"all":       "-return_status:no_return",
+}
+
+var returnsListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List returns",
+	Long: `List returns, most recently updated orders first.
+
+--status is one of requested, open, closed, declined, canceled, or all.
+Without --status, requested and open returns are listed. Use 'returns get' for a
+return's items, received quantities, and refunds.
+
+Examples:
+  shopify-admin returns list
+  shopify-admin returns list --status requested
+  shopify-admin returns list --order "#1001" --status all --json`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		status := strings.ToLower(returnsListStatus)
+		keep := func(r api.Return) bool {
+			switch status {
+			case "":
+				return r.Status == "REQUESTED" || r.Status == "OPEN"
+			case "all":
+				return true
+			}
+			return strings.EqualFold(r.Status, status)
+		}
+		query := "return_status:return_requested OR return_status:in_progress"
+		if status != "" {
+			q, ok := returnStatusQueries[status]
+			if !ok {
+				return fmt.Errorf("invalid --status %q: expected requested, open, closed, declined, canceled, or all", returnsListStatus)
+			}
+			query = q
+		}
+
+		var returns []api.Return
+		var pageInfo api.PageInfo
+		if returnsListOrder != "" {
+			orderID, err := resolveID("Order", returnsListOrder)
+			if err != nil {
+				return err
+			}
+			all, err := client.ListOrderReturns(orderID)
+			if err != nil {
+				return err
+			}
+			for _, r := range all {
+				if keep(r) {
+					returns = append(returns, r)
+				}
+			}
+		} else {
+			if returnsListFirst < 1 || returnsListFirst > 40 {
+				return fmt.Errorf("--first must be between 1 and 40")
+			}
+			conn, err := client.ListOrdersWithReturns(returnsListFirst, returnsListAfter, query)
+			if err != nil {
+				return err
+			}
+			for _, e := range conn.Edges {
+				if e.Node.Returns == nil {
+					continue
+				}
+				for _, re := range e.Node.Returns.Edges {
+					if keep(re.Node) {
+						returns = append(returns, re.Node)
+					}
+				}
+			}
+			pageInfo = conn.PageInfo
+		}
+
+		if output.IsJSON(cmd) {
+			if returns == nil {
+				returns = []api.Return{}
+			}
+			return output.PrintJSON(returns, output.IsPretty(cmd))
+		}
+		if len(returns) == 0 {
+			fmt.Println("No returns found.")
+			return nil
+		}
+		headers := []string{"ID", "NAME", "ORDER", "STATUS", "QTY", "CREATED", "CLOSED"}
+		rows := make([][]string, len(returns))
+		for i, r := range returns {
+			order := "-"
+			if r.Order != nil {
+				order = r.Order.Name
+			}
+			rows[i] = []string{
+				shortID(r.ID),
+				r.Name,
+				order,
+				strings.ToLower(r.Status),
+				fmt.Sprintf("%d", r.TotalQuantity),
+				output.FormatTime(r.CreatedAt),
+				output.FormatTime(r.ClosedAt),
+			}
+		}
+		output.PrintTable(headers, rows)
+		if pageInfo.HasNextPage {
+			fmt.Printf("\n(more results — use --after %s)\n", pageInfo.EndCursor)
+		}
+		return nil
+	},
+}
+
+// ---- returns get ----
+
+var returnsGetCmd = &cobra.Command{
+	Use:   "get <id>",
+	Short: "Get a return with its items, received quantities, and refunds",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := resolveID("Return", args[0])
+		if err != nil {
+			return err
+		}
+		r, err := client.GetReturn(id)
+		if err != nil {
+			return err
+		}
+		if output.IsJSON(cmd) {
+			return output.PrintJSON(r, output.IsPretty(cmd))
+		}
+		printReturn(r)
+		return nil
+	},
+}
+
+func printReturn(r *api.Return) {
+	order := "-"
+	if r.Order != nil {
+		order = r.Order.Name + " (" + shortID(r.Order.ID) + ")"
+	}
+	kv := [][]string{
+		{"ID", shortID(r.ID)},
+		{"Name", r.Name},
+		{"Order", order},
+		{"Status", strings.ToLower(r.Status)},
+		{"Quantity", fmt.Sprintf("%d", r.TotalQuantity)},
+		{"Created", output.FormatTime(r.CreatedAt)},
+		{"Closed", output.FormatTime(r.ClosedAt)},
+	}
+	if r.Decline != nil {
+		kv = append(kv, []string{"Declined", strings.ToLower(r.Decline.Reason) + " " + r.Decline.Note})
+	}
+	output.PrintKeyValue(kv)
+
+	if len(r.ReturnLineItems.Edges) > 0 {
+		fmt.Println()
+		fmt.Println("Items:")
+		headers := []string{"LINE", "SKU", "TITLE", "QTY", "REASON", "CUSTOMER NOTE"}
+		rows := make([][]string, len(r.ReturnLineItems.Edges))
+		for i, e := range r.ReturnLineItems.Edges {
+			li := e.Node
+			sku, title := "-", "-"
+			if li.FulfillmentLineItem != nil {
+				sku, title = orDash(li.FulfillmentLineItem.LineItem.SKU), li.FulfillmentLineItem.LineItem.Title
+			}
+			rows[i] = []string{
+				shortID(li.ID),
+				sku,
+				output.Truncate(title, 36),
+				fmt.Sprintf("%d", li.Quantity),
+				strings.ToLower(li.ReturnReason),
+				output.Truncate(orDash(li.CustomerNote), 40),
+			}
+		}
+		output.PrintTable(headers, rows)
+	}
+
+	var rows [][]string
+	for _, rfo := range r.ReverseFulfillmentOrders.Edges {
+		for _, e := range rfo.Node.LineItems.Edges {
+			li := e.Node
+			sku := "-"
+			if li.FulfillmentLineItem != nil {
+				sku = orDash(li.FulfillmentLineItem.LineItem.SKU)
+			}
+			var received []string
+			for _, d := range li.Dispositions {
+				s := fmt.Sprintf("%d %s", d.Quantity, strings.ToLower(d.Type))
+				if d.Location != nil {
+					s += " at " + d.Location.Name
+				}
+				received = append(received, s)
+			}
+			rows = append(rows, []string{
+				shortID(li.ID),
+				sku,
+				fmt.Sprintf("%d", li.TotalQuantity),
+				fmt.Sprintf("%d", reverseLineRemaining(li)),
+				orDash(strings.Join(received, ", ")),
+			})
+		}
+	}
+	if len(rows) > 0 {
+		fmt.Println()
+		fmt.Println("Receiving:")
+		output.PrintTable([]string{"LINE", "SKU", "QTY", "AWAITING", "RECEIVED"}, rows)
+	}
+
+	fmt.Println()
+	if len(r.Refunds.Edges) == 0 {
+		fmt.Println("Refunds: none linked yet.")
+		return
+	}
+	fmt.Println("Refunds:")
+	rows = make([][]string, len(r.Refunds.Edges))
+	for i, e := range r.Refunds.Edges {
+		rf := e.Node
+		rows[i] = []string{
+			shortID(rf.ID),
+			formatMoney(rf.TotalRefundedSet.ShopMoney.Amount, rf.TotalRefundedSet.ShopMoney.CurrencyCode),
+			output.FormatTime(rf.CreatedAt),
+			orDash(rf.Note),
+		}
+	}
+	output.PrintTable([]string{"ID", "AMOUNT", "CREATED", "NOTE"}, rows)
+}
+
+// reverseLineRemaining returns the units of a reverse fulfillment line not yet received.
+func reverseLineRemaining(li api.ReverseFulfillmentOrderLineItem) int {
+	n := li.TotalQuantity
+	for _, d := range li.Dispositions {
+		n -= d.Quantity
+	}
+	return n
+}
+
+// ---- returns request ----
+
+var (
+	returnRequestLines  []string
+	returnRequestReason string
+	returnRequestNote   string
+)
+
+var returnReasons = []string{
+	"COLOR", "DEFECTIVE", "NOT_AS_DESCRIBED", "OTHER", "SIZE_TOO_LARGE",
+	"SIZE_TOO_SMALL", "STYLE", "UNKNOWN", "UNWANTED", "WRONG_ITEM",
+}
+
+var returnsRequestCmd = &cobra.Command{
+	Use:   "request <order-id>",
+	Short: "Request a return for fulfilled items on an order",
+	Long: `Create a return request for fulfilled items. The return stays in 'requested'
+status until it is approved or declined.
+
+Lines are referenced by line item ID (see 'orders get') or by SKU.
+
+Reasons: color, defective, not_as_described, other, size_too_large,
+size_too_small, style, unknown, unwanted, wrong_item.
+
+Examples:
+  shopify-admin returns request "#1001" --line TSHIRT-BLUE-M:1 --reason size_too_small
+  shopify-admin returns request 1234567890 --line 987654:2 --reason defective --note "Seam split"`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(returnRequestLines) == 0 {
+			return fmt.Errorf("nothing to return — pass --line <line>:<qty>")
+		}
+		reason := strings.ToUpper(strings.ReplaceAll(returnRequestReason, "-", "_"))
+		valid := false
+		for _, r := range returnReasons {
+			valid = valid || r == reason
+		}
+		if !valid {
+			return fmt.Errorf("invalid --reason %q: expected one of %s", returnRequestReason,
+				strings.ToLower(strings.Join(returnReasons, ", ")))
+		}
+		orderID, err := resolveID("Order", args[0])
+		if err != nil {
+			return err
+		}
+		orderName, returnable, err := client.ListReturnableLines(orderID)
+		if err != nil {
+			return err
+		}
+		// The same line item can be split across several fulfillments, so match
+		// against distinct line items and then draw from each fulfillment in turn.
+		var ids, skus []string
+		byLine := map[string][]int{}
+		for i, rl := range returnable {
+			if _, seen := byLine[rl.LineItem.ID]; !seen {
+				ids = append(ids, rl.LineItem.ID)
+				skus = append(skus, rl.LineItem.SKU)
+			}
+			byLine[rl.LineItem.ID] = append(byLine[rl.LineItem.ID], i)
+		}
+		var lines []api.ReturnRequestLine
+		for _, spec := range returnRequestLines {
+			ref, qty, err := splitQuantitySpec(spec)
+			if err != nil {
+				return fmt.Errorf("--line: %w", err)
+			}
+			if qty == 0 {
+				return fmt.Errorf("--line %q: quantity must be at least 1", spec)
+			}
+			idx, err := matchLineRef(ref, orderName, ids, skus)
+			if err != nil {
+				return fmt.Errorf("%w (only fulfilled, not yet returned items can be returned)", err)
+			}
+			need := qty
+			for _, i := range byLine[ids[idx]] {
+				rl := &returnable[i]
+				take := rl.Quantity
+				if take > need {
+					take = need
+				}
+				if take == 0 {
+					continue
+				}
+				lines = append(lines, api.ReturnRequestLine{
+					FulfillmentLineItemID: rl.FulfillmentLineItemID,
+					Quantity:              take,
+					Reason:                reason,
+					CustomerNote:          returnRequestNote,
+				})
+				rl.Quantity -= take
+				need -= take
+			}
+			if need > 0 {
+				return fmt.Errorf("--line %q: only %d returnable", spec, qty-need)
+			}
+		}
+		r, err := client.RequestReturn(orderID, lines)
+		if err != nil {
+			return err
+		}
+		if output.IsJSON(cmd) {
+			return output.PrintJSON(r, output.IsPretty(cmd))
+		}
+		fmt.Printf("Return %s requested on %s (ID: %s, %d items).\n", r.Name, orderName, shortID(r.ID), r.TotalQuantity)
+		return nil
+	},
+}
+
+// ---- returns approve / decline ----
+
+var returnsApproveCmd = &cobra.Command{
+	Use:   "approve <id>",
+	Short: "Approve a requested return",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := resolveID("Return", args[0])
+		if err != nil {
+			return err
+		}
+		r, err := client.ApproveReturn(id)
+		if err != nil {
+			return err
+		}
+		if output.IsJSON(cmd) {
+			return output.PrintJSON(r, output.IsPretty(cmd))
+		}
+		fmt.Printf("Return %s approved (status: %s).\n", r.Name, strings.ToLower(r.Status))
+		return nil
+	},
+}
+
+var (
+	returnDeclineReason string
+	returnDeclineNote   string
+	returnDeclineNotify bool
+)
+
+var returnsDeclineCmd = &cobra.Command{
+	Use:   "decline <id>",
+	Short: "Decline a requested return",
+	Long: `Decline a requested return.
+
+Reasons: final_sale, return_period_ended, other.
+
+Examples:
+  shopify-admin returns decline 5551234 --reason final_sale --notify
+  shopify-admin returns decline 5551234 --note "Item shows wear" --notify`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		reason := strings.ToUpper(strings.ReplaceAll(returnDeclineReason, "-", "_"))
+		switch reason {
+		case "FINAL_SALE", "RETURN_PERIOD_ENDED", "OTHER":
+		default:
+			return fmt.Errorf("invalid --reason %q: expected final_sale, return_period_ended, or other", returnDeclineReason)
+		}
+		id, err := resolveID("Return", args[0])
+		if err != nil {
+			return err
+		}
+		r, err := client.DeclineReturn(id, reason, returnDeclineNote, returnDeclineNotify)
+		if err != nil {
+			return err
+		}
+		if output.IsJSON(cmd) {
+			return output.PrintJSON(r, output.IsPretty(cmd))
+		}
+		fmt.Printf("Return %s declined.\n", r.Name)
+		if returnDeclineNotify {
+			fmt.Println("Customer notified.")
+		}
+		return nil
+	},
+}
+
+// ---- returns receive ----
+
+var (
+	returnReceiveLines       []string
+	returnReceiveLocation    string
+	returnReceiveDisposition string
+)
+
+var returnsReceiveCmd = &cobra.Command{
+	Use:   "receive <id>",
+	Short: "Record returned items as received and restock them",
+	Long: `Record returned items as received. Without --line, every unit still awaited is
+received.
+
+Lines are referenced by receiving line ID (see 'returns get') or by SKU.
+
+Dispositions:
+  restocked            back in stock at --location (default)
+  processing_required  received but needs inspection; not yet sellable
+  not_restocked        received and written off
+  missing              never arrived
+
+Examples:
+  shopify-admin returns receive 5551234 --location 67890
+  shopify-admin returns receive 5551234 --line TSHIRT-BLUE-M:1 --location 67890
+  shopify-admin returns receive 5551234 --line TSHIRT-BLUE-M:1 --disposition not_restocked`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		disposition := strings.ToUpper(strings.ReplaceAll(returnReceiveDisposition, "-", "_"))
+		switch disposition {
+		case "RESTOCKED", "PROCESSING_REQUIRED", "NOT_RESTOCKED", "MISSING":
+		default:
+			return fmt.Errorf("invalid --disposition %q: expected restocked, processing_required, not_restocked, or missing", returnReceiveDisposition)
+		}
+		locationID := ""
+		if returnReceiveLocation != "" {
+			var err error
+			if locationID, err = resolveID("Location", returnReceiveLocation); err != nil {
+				return err
+			}
+		} else if disposition == "RESTOCKED" {
+			return fmt.Errorf("--location is required to restock (or pass another --disposition)")
+		}
+		id, err := resolveID("Return", args[0])
+		if err != nil {
+			return err
+		}
+		r, err := client.GetReturn(id)
+		if err != nil {
+			return err
+		}
+
+		var lines []api.ReverseFulfillmentOrderLineItem
+		var ids, skus []string
+		for _, rfo := range r.ReverseFulfillmentOrders.Edges {
+			for _, e := range rfo.Node.LineItems.Edges {
+				sku := ""
+				if e.Node.FulfillmentLineItem != nil {
+					sku = e.Node.FulfillmentLineItem.LineItem.SKU
+				}
+				lines = append(lines, e.Node)
+				ids = append(ids, e.Node.ID)
+				skus = append(skus, sku)
+			}
+		}
+		if len(lines) == 0 {
+			return fmt.Errorf("return %s has nothing to receive (status: %s) — approve it first", r.Name, strings.ToLower(r.Status))
+		}
+		var inputs []api.ReverseDispositionInput
+		if len(returnReceiveLines) == 0 {
+			for _, li := range lines {
+				if n := reverseLineRemaining(li); n > 0 {
+					inputs = append(inputs, api.ReverseDispositionInput{LineItemID: li.ID, Quantity: n, Type: disposition, LocationID: locationID})
+				}
+			}
+			if len(inputs) == 0 {
+				return fmt.Errorf("all items on return %s have already been received", r.Name)
+			}
+		}
+		for _, spec := range returnReceiveLines {
+			ref, qty, err := splitQuantitySpec(spec)
+			if err != nil {
+				return fmt.Errorf("--line: %w", err)
+			}
+			i, err := matchLineRef(ref, r.Name, ids, skus)
+			if err != nil {
+				return err
+			}
+			if remaining := reverseLineRemaining(lines[i]); qty == 0 || qty > remaining {
+				return fmt.Errorf("--line %q: quantity must be between 1 and %d", spec, remaining)
+			}
+			inputs = append(inputs, api.ReverseDispositionInput{LineItemID: lines[i].ID, Quantity: qty, Type: disposition, LocationID: locationID})
+		}
+		if err := client.DisposeReverseFulfillment(inputs); err != nil {
+			return err
+		}
+		if r, err = client.GetReturn(id); err != nil {
+			return err
+		}
+		if output.IsJSON(cmd) {
+			return output.PrintJSON(r, output.IsPretty(cmd))
+		}
+		received := 0
+		for _, in := range inputs {
+			received += in.Quantity
+		}
+		fmt.Printf("Received %d items on return %s (%s).\n\n", received, r.Name, strings.ToLower(strings.ReplaceAll(disposition, "_", " ")))
+		printReturn(r)
+		return nil
+	},
+}
+
+// ---- returns close ----
+
+var returnsCloseCmd = &cobra.Command{
+	Use:   "close <id>",
+	Short: "Close a return once it is received and refunded",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := resolveID("Return", args[0])
+		if err != nil {
+			return err
+		}
+		current, err := client.GetReturn(id)
+		if err != nil {
+			return err
+		}
+		r, err := client.CloseReturn(id)
+		if err != nil {
+			return err
+		}
+		if output.IsJSON(cmd) {
+			return output.PrintJSON(r, output.IsPretty(cmd))
+		}
+		fmt.Printf("Return %s closed.\n", r.Name)
+		if len(current.Refunds.Edges) == 0 {
+			fmt.Fprintln(os.Stderr, "Note: no refund is linked to this return.")
+		}
+		return nil
+	},
+}
+
+func init() {
+	returnsListCmd.Flags().StringVar(&returnsListStatus, "status", "", "Filter by status: requested, open, closed, declined, canceled, all")
+	returnsListCmd.Flags().StringVar(&returnsListOrder, "order", "", "Only returns on this order")
+	returnsListCmd.Flags().IntVar(&returnsListFirst, "first", 25, "Number of orders to scan (max 40)")
+	returnsListCmd.Flags().StringVar(&returnsListAfter, "after", "", "Pagination cursor")
+
+	returnsRequestCmd.Flags().StringArrayVar(&returnRequestLines, "line", nil, "Item to return: <line>:<qty> (repeatable)")
+	returnsRequestCmd.Flags().StringVar(&returnRequestReason, "reason", "unknown", "Return reason")
+	returnsRequestCmd.Flags().StringVar(&returnRequestNote, "note", "", "Customer note for the returned items")
+
+	returnsDeclineCmd.Flags().StringVar(&returnDeclineReason, "reason", "other", "Decline reason: final_sale, return_period_ended, other")
+	returnsDeclineCmd.Flags().StringVar(&returnDeclineNote, "note", "", "Explanation shown to the customer")
+	returnsDeclineCmd.Flags().BoolVar(&returnDeclineNotify, "notify", false, "Email the customer")
+
+	returnsReceiveCmd.Flags().StringArrayVar(&returnReceiveLines, "line", nil, "Item received: <line>:<qty> (repeatable; default all awaited)")
+	returnsReceiveCmd.Flags().StringVar(&returnReceiveLocation, "location", "", "Location to restock at")
+	returnsReceiveCmd.Flags().StringVar(&returnReceiveDisposition, "disposition", "restocked", "restocked, processing_required, not_restocked, or missing")
+
+	returnsCmd.AddCommand(
+		returnsListCmd,
+		returnsGetCmd,
+		returnsRequestCmd,
+		returnsApproveCmd,
+		returnsDeclineCmd,
+		returnsReceiveCmd,
+		returnsCloseCmd,
+	)
+	rootCmd.AddCommand(returnsCmd)
+}
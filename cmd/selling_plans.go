@@ -0,0 +1,376 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/the20100/shopify-admin-cli/internal/api"
+	"github.com/the20100/shopify-admin-cli/internal/output"
+)
+
+var sellingPlansCmd = &cobra.Command{
+	Use:   "selling-plans",
+	Short: "Manage selling plan groups (subscriptions, pre-orders)",
+}
+
+// ---- selling-plans list ----
+
+var (
+	sellingPlansListFirst int
+	sellingPlansListAfter string
+	sellingPlansListQuery string
+)
+
+var sellingPlansListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List selling plan groups",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		conn, err := client.ListSellingPlanGroups(sellingPlansListFirst, sellingPlansListAfter, sellingPlansListQuery)
+		if err != nil {
+			return err
+		}
+		if output.IsJSON(cmd) {
+			items := make([]any, len(conn.Edges))
+			for i, e := range conn.Edges {
+				items[i] = e.Node
+			}
+			return output.PrintJSON(items, output.IsPretty(cmd))
+		}
+		if len(conn.Edges) == 0 {
+			fmt.Println("No selling plan groups found.")
+			return nil
+		}
+		headers := []string{"ID", "NAME", "MERCHANT CODE", "PRODUCTS", "SUMMARY"}
+		rows := make([][]string, len(conn.Edges))
+		for i, e := range conn.Edges {
+			g := e.Node
+			products := "-"
+			if g.ProductsCount != nil {
+				products = fmt.Sprintf("%d", g.ProductsCount.Count)
+			}
+			rows[i] = []string{
+				shortID(g.ID),
+				output.Truncate(g.Name, 30),
+				g.MerchantCode,
+				products,
+				output.Truncate(g.Summary, 50),
+			}
+		}
+		output.PrintTable(headers, rows)
+		if conn.PageInfo.HasNextPage {
+			fmt.Printf("\n(more results — use --after %s)\n", conn.PageInfo.EndCursor)
+		}
+		return nil
+	},
+}
+
+// ---- selling-plans get ----
+
+var sellingPlansGetCmd = &cobra.Command{
+	Use:   "get <id>",
+	Short: "Get a selling plan group with its plans and products",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := resolveID("SellingPlanGroup", args[0])
+		if err != nil {
+			return err
+		}
+		g, err := client.GetSellingPlanGroup(id)
+		if err != nil {
+			return err
+		}
+		if output.IsJSON(cmd) {
+			return output.PrintJSON(g, output.IsPretty(cmd))
+		}
+		products := "-"
+		if g.ProductsCount != nil {
+			products = fmt.Sprintf("%d", g.ProductsCount.Count)
+		}
+		output.PrintKeyValue([][]string{
+			{"ID", shortID(g.ID)},
+			{"Name", g.Name},
+			{"Merchant Code", g.MerchantCode},
+			{"Description", g.Description},
+			{"Summary", g.Summary},
+			{"Options", output.FormatLabels(g.Options)},
+			{"Products", products},
+			{"Created", output.FormatTime(g.CreatedAt)},
+		})
+		if len(g.SellingPlans.Edges) > 0 {
+			fmt.Println()
+			fmt.Println("Selling plans:")
+			headers := []string{"ID", "NAME", "CATEGORY", "BILLING", "DELIVERY", "PRICING"}
+			rows := make([][]string, len(g.SellingPlans.Edges))
+			for i, e := range g.SellingPlans.Edges {
+				p := e.Node
+				rows[i] = []string{
+					shortID(p.ID),
+					output.Truncate(p.Name, 30),
+					strings.ToLower(p.Category),
+					describeSellingPlanPolicy(p.BillingPolicy),
+					describeSellingPlanPolicy(p.DeliveryPolicy),
+					describePricingPolicies(p.PricingPolicies),
+				}
+			}
+			output.PrintTable(headers, rows)
+		}
+		if len(g.Products.Edges) > 0 {
+			fmt.Println()
+			fmt.Println("Products:")
+			headers := []string{"ID", "TITLE"}
+			rows := make([][]string, len(g.Products.Edges))
+			for i, e := range g.Products.Edges {
+				rows[i] = []string{shortID(e.Node.ID), output.Truncate(e.Node.Title, 50)}
+			}
+			output.PrintTable(headers, rows)
+			if g.Products.PageInfo.HasNextPage {
+				fmt.Println("(more products not shown)")
+			}
+		}
+		return nil
+	},
+}
+
+// describeSellingPlanPolicy summarises a billing or delivery policy, e.g. "every 2 month".
+func describeSellingPlanPolicy(p api.SellingPlanPolicy) string {
+	switch {
+	case p.Interval != "":
+		return fmt.Sprintf("every %d %s", p.IntervalCount, strings.ToLower(p.Interval))
+	case p.RemainingBalanceChargeTrigger != "":
+		desc := "fixed, balance " + strings.ToLower(p.RemainingBalanceChargeTrigger)
+		if p.CheckoutCharge != nil {
+			desc += ", checkout " + describePriceValue(p.CheckoutCharge.Value)
+		}
+		return desc
+	case p.FulfillmentTrigger != "":
+		if p.FulfillmentExactTime != "" {
+			return "fixed, ships " + output.FormatTime(p.FulfillmentExactTime)
+		}
+		return "fixed, " + strings.ToLower(p.FulfillmentTrigger)
+	}
+	return "-"
+}
+
+// describePricingPolicies summarises pricing adjustments, e.g. "percentage 10% / percentage 15% after 3".
+func describePricingPolicies(policies []api.SellingPlanPricingPolicy) string {
+	if len(policies) == 0 {
+		return "-"
+	}
+	parts := make([]string, len(policies))
+	for i, p := range policies {
+		desc := strings.ToLower(p.AdjustmentType) + " " + describePriceValue(p.AdjustmentValue)
+		if p.AfterCycle > 0 {
+			desc += fmt.Sprintf(" after %d", p.AfterCycle)
+		}
+		parts[i] = desc
+	}
+	return strings.Join(parts, " / ")
+}
+
+func describePriceValue(v api.SellingPlanPriceValue) string {
+	if v.Amount != "" {
+		return formatMoney(v.Amount, v.CurrencyCode)
+	}
+	return fmt.Sprintf("%g%%", v.Percentage)
+}
+
+// ---- selling-plans create ----
+
+var (
+	sellingPlanCreateFile     string
+	sellingPlanCreateProducts []string
+)
+
+var sellingPlansCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create a selling plan group from a YAML or JSON file",
+	Long: `Create a selling plan group. The file holds a SellingPlanGroupInput, using the
+Admin API field names as keys.
+
+Example file (subscribe & save, monthly or every 2 months, 10% off):
+
+  name: Subscribe & Save
+  merchantCode: subscribe-save
+  options: ["Delivery every"]
+  sellingPlansToCreate:
+    - name: Monthly
+      options: ["1 month"]
+      category: SUBSCRIPTION
+      billingPolicy:
+        recurring: { interval: MONTH, intervalCount: 1 }
+      deliveryPolicy:
+        recurring: { interval: MONTH, intervalCount: 1 }
+      pricingPolicies:
+        - fixed: { adjustmentType: PERCENTAGE, adjustmentValue: { percentage: 10 } }
+    - name: Every 2 months
+      options: ["2 months"]
+      category: SUBSCRIPTION
+      billingPolicy:
+        recurring: { interval: MONTH, intervalCount: 2 }
+      deliveryPolicy:
+        recurring: { interval: MONTH, intervalCount: 2 }
+
+Pre-order example plan (charge nothing now, ship on a date):
+
+      category: PRE_ORDER
+      billingPolicy:
+        fixed:
+          checkoutCharge: { type: PERCENTAGE, value: { percentage: 0 } }
+          remainingBalanceChargeTrigger: TIME_AFTER_CHECKOUT
+          remainingBalanceChargeTimeAfterCheckout: P30D
+      deliveryPolicy:
+        fixed: { fulfillmentTrigger: EXACT_TIME, fulfillmentExactTime: "2026-12-01T00:00:00Z" }
+
+Examples:
+  shopify-admin selling-plans create --file subscribe.yaml
+  shopify-admin selling-plans create --file preorder.yaml --product 1234567890 --product 2345678901`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if sellingPlanCreateFile == "" {
+			return fmt.Errorf("--file is required")
+		}
+		var input map[string]any
+		if err := loadSpecFile(sellingPlanCreateFile, &input); err != nil {
+			return err
+		}
+		productIDs, err := resolveIDs("Product", sellingPlanCreateProducts)
+		if err != nil {
+			return err
+		}
+		g, err := client.CreateSellingPlanGroup(input, productIDs)
+		if err != nil {
+			return err
+		}
+		if output.IsJSON(cmd) {
+			return output.PrintJSON(g, output.IsPretty(cmd))
+		}
+		fmt.Printf("Selling plan group created: %s\n", g.Name)
+		fmt.Printf("ID:      %s\n", shortID(g.ID))
+		fmt.Printf("Summary: %s\n", g.Summary)
+		return nil
+	},
+}
+
+// ---- selling-plans update ----
+
+var (
+	sellingPlanUpdateFile string
+	sellingPlanUpdateName string
+)
+
+var sellingPlansUpdateCmd = &cobra.Command{
+	Use:   "update <id>",
+	Short: "Update a selling plan group",
+	Long: `Update a selling plan group from a YAML or JSON SellingPlanGroupInput file.
+Use sellingPlansToCreate, sellingPlansToUpdate (with plan id) and sellingPlansToDelete
+to change individual plans.
+
+Examples:
+  shopify-admin selling-plans update 1234567890 --name "Subscribe & Save 15%"
+  shopify-admin selling-plans update 1234567890 --file changes.yaml`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		input := map[string]any{}
+		if sellingPlanUpdateFile != "" {
+			if err := loadSpecFile(sellingPlanUpdateFile, &input); err != nil {
+				return err
+			}
+		}
+		if sellingPlanUpdateName != "" {
+			input["name"] = sellingPlanUpdateName
+		}
+		if len(input) == 0 {
+			return fmt.Errorf("nothing to update — pass --file or --name")
+		}
+		id, err := resolveID("SellingPlanGroup", args[0])
+		if err != nil {
+			return err
+		}
+		g, err := client.UpdateSellingPlanGroup(id, input)
+		if err != nil {
+			return err
+		}
+		if output.IsJSON(cmd) {
+			return output.PrintJSON(g, output.IsPretty(cmd))
+		}
+		fmt.Printf("Selling plan group updated: %s\n", g.Name)
+		fmt.Printf("ID:      %s\n", shortID(g.ID))
+		fmt.Printf("Summary: %s\n", g.Summary)
+		return nil
+	},
+}
+
+// ---- selling-plans delete ----
+
+var sellingPlansDeleteCmd = &cobra.Command{
+	Use:   "delete <id>",
+	Short: "Delete a selling plan group (irreversible)",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := resolveID("SellingPlanGroup", args[0])
+		if err != nil {
+			return err
+		}
+		if err := client.DeleteSellingPlanGroup(id); err != nil {
+			return err
+		}
+		fmt.Printf("Selling plan group %s deleted.\n", args[0])
+		return nil
+	},
+}
+
+// ---- selling-plans add-products ----
+
+var sellingPlansAddProductsCmd = &cobra.Command{
+	Use:   "add-products <group-id> <product-id>...",
+	Short: "Attach products to a selling plan group",
+	Long: `Attach one or more products to a selling plan group.
+
+Examples:
+  shopify-admin selling-plans add-products 1234567890 111 222 333`,
+	Args: cobra.MinimumNArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := resolveID("SellingPlanGroup", args[0])
+		if err != nil {
+			return err
+		}
+		productIDs, err := resolveIDs("Product", args[1:])
+		if err != nil {
+			return err
+		}
+		g, err := client.AddProductsToSellingPlanGroup(id, productIDs)
+		if err != nil {
+			return err
+		}
+		if output.IsJSON(cmd) {
+			return output.PrintJSON(g, output.IsPretty(cmd))
+		}
+		fmt.Printf("Added %d products to %s.\n", len(args)-1, g.Name)
+		if g.ProductsCount != nil {
+			fmt.Printf("Products in group: %d\n", g.ProductsCount.Count)
+		}
+		return nil
+	},
+}
+
+func init() {
+	sellingPlansListCmd.Flags().IntVar(&sellingPlansListFirst, "first", 50, "Number of selling plan groups to return")
+	sellingPlansListCmd.Flags().StringVar(&sellingPlansListAfter, "after", "", "Pagination cursor")
+	sellingPlansListCmd.Flags().StringVar(&sellingPlansListQuery, "query", "", "Search query")
+
+	sellingPlansCreateCmd.Flags().StringVar(&sellingPlanCreateFile, "file", "", "YAML or JSON SellingPlanGroupInput file (required)")
+	sellingPlansCreateCmd.Flags().StringArrayVar(&sellingPlanCreateProducts, "product", nil, "Product ID to attach (repeatable)")
+
+	sellingPlansUpdateCmd.Flags().StringVar(&sellingPlanUpdateFile, "file", "", "YAML or JSON SellingPlanGroupInput file")
+	sellingPlansUpdateCmd.Flags().StringVar(&sellingPlanUpdateName, "name", "", "New group name")
+
+	sellingPlansCmd.AddCommand(
+		sellingPlansListCmd,
+		sellingPlansGetCmd,
+		sellingPlansCreateCmd,
+		sellingPlansUpdateCmd,
+		sellingPlansDeleteCmd,
+		sellingPlansAddProductsCmd,
+	)
+	rootCmd.AddCommand(sellingPlansCmd)
+}
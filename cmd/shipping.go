@@ -0,0 +1,335 @@
+package cmd
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/the20100/shopify-admin-cli/internal/api"
+	"github.com/the20100/shopify-admin-cli/internal/output"
+)
+
+var shippingCmd = &cobra.Command{
+	Use:   "shipping",
+	Short: "View and manage shipping profiles, zones, and rates",
+}
+
+var shippingProfilesCmd = &cobra.Command{
+	Use:   "profiles",
+	Short: "List, inspect, and apply shipping profiles",
+}
+
+// weightUnits maps the weight units accepted in profile files to Shopify's WeightUnit.
+var weightUnits = map[string]string{
+	"g":  "GRAMS",
+	"kg": "KILOGRAMS",
+	"oz": "OUNCES",
+	"lb": "POUNDS",
+}
+
+// weightUnitLabel returns the short label for a WeightUnit, e.g. "kg" for KILOGRAMS.
+func weightUnitLabel(unit string) string {
+	for label, u := range weightUnits {
+		if u == unit {
+			return label
+		}
+	}
+	return strings.ToLower(unit)
+}
+
+// rateCondition is a weight or price bound on a shipping rate.
+type rateCondition struct {
+	field    string // TOTAL_WEIGHT or TOTAL_PRICE
+	operator string // GREATER_THAN_OR_EQUAL_TO or LESS_THAN_OR_EQUAL_TO
+	value    float64
+	unit     string // WeightUnit for weight, currency code for price
+}
+
+func (c rateCondition) String() string {
+	op := "≥"
+	if c.operator == "LESS_THAN_OR_EQUAL_TO" {
+		op = "≤"
+	}
+	if c.field == "TOTAL_WEIGHT" {
+		return fmt.Sprintf("weight %s %s %s", op, strconv.FormatFloat(c.value, 'f', -1, 64), weightUnitLabel(c.unit))
+	}
+	return fmt.Sprintf("price %s %.2f %s", op, c.value, c.unit)
+}
+
+// rateConditions converts a method definition's conditions to rateConditions.
+func rateConditions(md api.DeliveryMethodDefinition) []rateCondition {
+	conds := make([]rateCondition, len(md.MethodConditions))
+	for i, c := range md.MethodConditions {
+		rc := rateCondition{field: c.Field, operator: c.Operator}
+		if c.ConditionCriteria.Typename == "Weight" {
+			rc.value, rc.unit = c.ConditionCriteria.Value, c.ConditionCriteria.Unit
+		} else {
+			rc.value, rc.unit = parseAmount(c.ConditionCriteria.Amount), c.ConditionCriteria.CurrencyCode
+		}
+		conds[i] = rc
+	}
+	return conds
+}
+
+// formatConditions joins conditions as "weight ≥ 2 kg, weight ≤ 5 kg" in a stable order.
+func formatConditions(conds []rateCondition) string {
+	labels := make([]string, len(conds))
+	for i, c := range conds {
+		labels[i] = c.String()
+	}
+	sort.Strings(labels)
+	return strings.Join(labels, ", ")
+}
+
+// formatRate describes what a method definition charges: a flat price or the
+// carrier service that calculates it.
+func formatRate(md api.DeliveryMethodDefinition) string {
+	p := md.RateProvider
+	switch {
+	case p.Price != nil:
+		return formatMoney(p.Price.Amount, p.Price.CurrencyCode)
+	case p.CarrierService != nil:
+		return "calculated by " + p.CarrierService.FormattedName
+	}
+	return "-"
+}
+
+// formatZoneCountries lists a zone's countries as "US, CA (ON, BC)".
+func formatZoneCountries(z api.DeliveryZone) string {
+	names := make([]string, len(z.Countries))
+	for i, c := range z.Countries {
+		names[i] = countryLabel(c)
+	}
+	return strings.Join(names, ", ")
+}
+
+// countryLabel formats one zone country, listing its provinces when the zone
+// covers only some of them.
+func countryLabel(c api.DeliveryCountry) string {
+	if c.Code.RestOfWorld {
+		return "Rest of world"
+	}
+	label := c.Code.CountryCode
+	if codes := selectedProvinces(c); len(codes) > 0 {
+		label += " (" + strings.Join(codes, ", ") + ")"
+	}
+	return label
+}
+
+// selectedProvinces returns the province codes of a zone country that covers only
+// some of its provinces, and nil when it covers the whole country.
+func selectedProvinces(c api.DeliveryCountry) []string {
+	if len(c.Provinces) == 0 {
+		return nil
+	}
+	codes := make([]string, len(c.Provinces))
+	included := map[string]bool{}
+	for i, p := range c.Provinces {
+		codes[i] = p.Code
+		included[p.Code] = true
+	}
+	full, ok := countryProvinces[c.Code.CountryCode]
+	if !ok {
+		return nil
+	}
+	for _, code := range full {
+		if !included[code] {
+			return codes
+		}
+	}
+	return nil
+}
+
+// countryProvinces lists the provinces of countries that shipping zones can limit
+// to some provinces. Shopify lists every province of a country a zone fully
+// covers, so a zone missing any of these covers only part of the country;
+// territories Shopify also lists don't count. For other countries, a zone's
+// provinces are taken as the whole country.
+var countryProvinces = map[string][]string{
+	"AU": {"ACT", "NSW", "NT", "QLD", "SA", "TAS", "VIC", "WA"},
+	"BR": {
+		"AC", "AL", "AM", "AP", "BA", "CE", "DF", "ES", "GO", "MA", "MG", "MS", "MT", "PA",
+		"PB", "PE", "PI", "PR", "RJ", "RN", "RO", "RR", "RS", "SC", "SE", "SP", "TO",
+	},
+	"CA": {"AB", "BC", "MB", "NB", "NL", "NS", "NT", "NU", "ON", "PE", "QC", "SK", "YT"},
+	"US": {
+		"AK", "AL", "AR", "AZ", "CA", "CO", "CT", "DC", "DE", "FL", "GA", "HI", "IA",
+		"ID", "IL", "IN", "KS", "KY", "LA", "MA", "MD", "ME", "MI", "MN", "MO", "MS",
+		"MT", "NC", "ND", "NE", "NH", "NJ", "NM", "NV", "NY", "OH", "OK", "OR", "PA",
+		"RI", "SC", "SD", "TN", "TX", "UT", "VA", "VT", "WA", "WI", "WV", "WY",
+	},
+}
+
+// findDeliveryProfile resolves a profile reference: a numeric ID or GID, a
+// profile name, or "" for the shop's default profile.
+func findDeliveryProfile(ref string) (string, error) {
+	if isNumeric(ref) || strings.HasPrefix(ref, "gid://") {
+		return resolveID("DeliveryProfile", ref)
+	}
+	after := ""
+	for {
+		conn, err := client.ListDeliveryProfiles(50, after)
+		if err != nil {
+			return "", err
+		}
+		for _, e := range conn.Edges {
+			if (ref == "" && e.Node.Default) || (ref != "" && strings.EqualFold(e.Node.Name, ref)) {
+				return e.Node.ID, nil
+			}
+		}
+		if !conn.PageInfo.HasNextPage {
+			break
+		}
+		after = conn.PageInfo.EndCursor
+	}
+	if ref == "" {
+		return "", fmt.Errorf("no default shipping profile found")
+	}
+	return "", fmt.Errorf("shipping profile %q not found", ref)
+}
+
+// ---- shipping profiles list ----
+
+var shippingProfilesListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List shipping profiles",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var profiles []api.DeliveryProfile
+		after := ""
+		for {
+			conn, err := client.ListDeliveryProfiles(50, after)
+			if err != nil {
+				return err
+			}
+			for _, e := range conn.Edges {
+				profiles = append(profiles, e.Node)
+			}
+			if !conn.PageInfo.HasNextPage {
+				break
+			}
+			after = conn.PageInfo.EndCursor
+		}
+		if output.IsJSON(cmd) {
+			if profiles == nil {
+				profiles = []api.DeliveryProfile{}
+			}
+			return output.PrintJSON(profiles, output.IsPretty(cmd))
+		}
+		if len(profiles) == 0 {
+			fmt.Println("No shipping profiles found.")
+			return nil
+		}
+		headers := []string{"ID", "NAME", "DEFAULT", "VARIANTS", "LOCATIONS", "COUNTRIES", "RATES", "LOCATIONS WITHOUT RATES"}
+		rows := make([][]string, len(profiles))
+		for i, p := range profiles {
+			variants := "-"
+			if p.ProductVariantsCount != nil {
+				variants = strconv.Itoa(p.ProductVariantsCount.Count)
+			}
+			rows[i] = []string{
+				shortID(p.ID),
+				output.Truncate(p.Name, 30),
+				yesNo(p.Default),
+				variants,
+				strconv.Itoa(p.OriginLocationCount),
+				strconv.Itoa(p.ZoneCountryCount),
+				strconv.Itoa(p.ActiveMethodDefinitionsCount),
+				strconv.Itoa(p.LocationsWithoutRatesCount),
+			}
+		}
+		output.PrintTable(headers, rows)
+		return nil
+	},
+}
+
+// ---- shipping profiles get ----
+
+var shippingProfilesGetCmd = &cobra.Command{
+	Use:   "get [<id>|<name>]",
+	Short: "Show a shipping profile's location groups, zones, and rates",
+	Long: `Show a shipping profile's location groups, and for each group its zones, the
+countries they cover, and the rates offered with their weight or price conditions.
+Without an argument, the default profile is shown.
+
+Examples:
+  shopify-admin shipping profiles get
+  shopify-admin shipping profiles get "Heavy goods"
+  shopify-admin shipping profiles get 1234567890 --json`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ref := ""
+		if len(args) == 1 {
+			ref = args[0]
+		}
+		id, err := findDeliveryProfile(ref)
+		if err != nil {
+			return err
+		}
+		p, err := client.GetDeliveryProfile(id)
+		if err != nil {
+			return err
+		}
+		if output.IsJSON(cmd) {
+			return output.PrintJSON(p, output.IsPretty(cmd))
+		}
+		printDeliveryProfile(p)
+		return nil
+	},
+}
+
+func printDeliveryProfile(p *api.DeliveryProfile) {
+	variants := "-"
+	if p.ProductVariantsCount != nil {
+		variants = strconv.Itoa(p.ProductVariantsCount.Count)
+	}
+	output.PrintKeyValue([][]string{
+		{"ID", shortID(p.ID)},
+		{"Name", p.Name},
+		{"Default", yesNo(p.Default)},
+		{"Variants", variants},
+		{"Locations without rates", strconv.Itoa(p.LocationsWithoutRatesCount)},
+	})
+	for _, g := range p.ProfileLocationGroups {
+		locations := make([]string, len(g.LocationGroup.Locations.Edges))
+		for i, e := range g.LocationGroup.Locations.Edges {
+			locations[i] = e.Node.Name
+		}
+		if g.LocationGroup.Locations.PageInfo.HasNextPage {
+			locations = append(locations, "…")
+		}
+		fmt.Printf("\nLocation group %s: %s\n", shortID(g.LocationGroup.ID), orDash(strings.Join(locations, ", ")))
+		if len(g.LocationGroupZones.Edges) == 0 {
+			fmt.Println("  No zones.")
+			continue
+		}
+		for _, ze := range g.LocationGroupZones.Edges {
+			z := ze.Node
+			fmt.Printf("\nZone %q (%s): %s\n", z.Zone.Name, shortID(z.Zone.ID), orDash(formatZoneCountries(z.Zone)))
+			if len(z.MethodDefinitions.Edges) == 0 {
+				fmt.Println("  No rates.")
+				continue
+			}
+			headers := []string{"RATE ID", "NAME", "PRICE", "CONDITIONS", "ACTIVE"}
+			rows := make([][]string, len(z.MethodDefinitions.Edges))
+			for i, me := range z.MethodDefinitions.Edges {
+				md := me.Node
+				rows[i] = []string{
+					shortID(md.ID),
+					output.Truncate(md.Name, 30),
+					formatRate(md),
+					orDash(formatConditions(rateConditions(md))),
+					yesNo(md.Active),
+				}
+			}
+			output.PrintTable(headers, rows)
+		}
+	}
+}
+
+func init() {
+	shippingProfilesCmd.AddCommand(shippingProfilesListCmd, shippingProfilesGetCmd)
+	shippingCmd.AddCommand(shippingProfilesCmd)
+	rootCmd.AddCommand(shippingCmd)
+}
@@ -0,0 +1,641 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/spf13/cobra"
+	"github.com/the20100/shopify-admin-cli/internal/api"
+	"github.com/the20100/shopify-admin-cli/internal/output"
+)
+
+// ---- shipping profiles apply ----
+
+var (
+	shippingApplyDryRun bool
+	shippingApplyYes    bool
+)
+
+// shippingSpec is the declarative profile file read by 'shipping profiles apply'.
+type shippingSpec struct {
+	Profile        string              `yaml:"profile"`
+	Currency       string              `yaml:"currency"`
+	LocationGroups []shippingGroupSpec `yaml:"locationGroups"`
+}
+
+type shippingGroupSpec struct {
+	ID    string             `yaml:"id"`
+	Zones []shippingZoneSpec `yaml:"zones"`
+}
+
+type shippingZoneSpec struct {
+	Name      string             `yaml:"name"`
+	Countries []string           `yaml:"countries"`
+	Rates     []shippingRateSpec `yaml:"rates"`
+}
+
+type shippingRateSpec struct {
+	Name        string `yaml:"name"`
+	Price       string `yaml:"price"`
+	Description string `yaml:"description"`
+	Active      *bool  `yaml:"active"`
+	MinWeight   string `yaml:"minWeight"`
+	MaxWeight   string `yaml:"maxWeight"`
+	MinPrice    string `yaml:"minPrice"`
+	MaxPrice    string `yaml:"maxPrice"`
+}
+
+// shippingChange is one line of the diff between a profile and its file.
+type shippingChange struct {
+	Op     string `json:"op"`   // create, update, or delete
+	Kind   string `json:"kind"` // zone or rate
+	Zone   string `json:"zone"`
+	Rate   string `json:"rate,omitempty"`
+	Detail string `json:"detail,omitempty"`
+}
+
+func (c shippingChange) String() string {
+	sign := map[string]string{"create": "+", "update": "~", "delete": "-"}[c.Op]
+	s := fmt.Sprintf("%s zone %q", sign, c.Zone)
+	if c.Kind == "rate" {
+		s = fmt.Sprintf("%s rate %q / %q", sign, c.Zone, c.Rate)
+	}
+	if c.Detail != "" {
+		s += ": " + c.Detail
+	}
+	return s
+}
+
+// zoneCountry is a parsed country entry: "US", "CA:ON,BC", or "REST_OF_WORLD".
+type zoneCountry struct {
+	code        string
+	restOfWorld bool
+	provinces   []string
+}
+
+func parseZoneCountry(s string) (zoneCountry, error) {
+	s = strings.ToUpper(strings.TrimSpace(s))
+	if s == "REST_OF_WORLD" || s == "*" {
+		return zoneCountry{restOfWorld: true}, nil
+	}
+	code, provinces, _ := strings.Cut(s, ":")
+	if len(code) != 2 {
+		return zoneCountry{}, fmt.Errorf("invalid country %q: expected a 2-letter code, CODE:PROVINCE,..., or REST_OF_WORLD", s)
+	}
+	c := zoneCountry{code: code, provinces: splitTags(provinces)}
+	sort.Strings(c.provinces)
+	return c, nil
+}
+
+func (c zoneCountry) String() string {
+	if c.restOfWorld {
+		return "REST_OF_WORLD"
+	}
+	if len(c.provinces) > 0 {
+		return c.code + ":" + strings.Join(c.provinces, ",")
+	}
+	return c.code
+}
+
+func (c zoneCountry) input() map[string]any {
+	if c.restOfWorld {
+		return map[string]any{"restOfWorld": true}
+	}
+	in := map[string]any{"code": c.code}
+	if len(c.provinces) == 0 {
+		in["includeAllProvinces"] = true
+		return in
+	}
+	provinces := make([]map[string]any, len(c.provinces))
+	for i, p := range c.provinces {
+		provinces[i] = map[string]any{"code": p}
+	}
+	in["provinces"] = provinces
+	return in
+}
+
+// countriesChanged reports whether a zone covers different countries or
+// provinces than the file lists. A country listed without provinces matches
+// only a zone that covers the whole country, not a selection of provinces.
+func countriesChanged(z api.DeliveryZone, countries []zoneCountry) bool {
+	current := map[string]api.DeliveryCountry{}
+	for _, c := range z.Countries {
+		key := c.Code.CountryCode
+		if c.Code.RestOfWorld {
+			key = "REST_OF_WORLD"
+		}
+		current[key] = c
+	}
+	if len(current) != len(countries) {
+		return true
+	}
+	for _, c := range countries {
+		key := c.code
+		if c.restOfWorld {
+			key = "REST_OF_WORLD"
+		}
+		cur, ok := current[key]
+		if !ok {
+			return true
+		}
+		if len(c.provinces) == 0 {
+			if len(selectedProvinces(cur)) > 0 {
+				return true
+			}
+			continue
+		}
+		codes := make([]string, len(cur.Provinces))
+		for i, p := range cur.Provinces {
+			codes[i] = p.Code
+		}
+		sort.Strings(codes)
+		if strings.Join(codes, ",") != strings.Join(c.provinces, ",") {
+			return true
+		}
+	}
+	return false
+}
+
+// parseWeight parses a weight such as "2 kg" or "500g".
+func parseWeight(s string) (float64, string, error) {
+	s = strings.TrimSpace(s)
+	i := strings.IndexFunc(s, unicode.IsLetter)
+	if i > 0 {
+		value, err := strconv.ParseFloat(strings.TrimSpace(s[:i]), 64)
+		if unit, ok := weightUnits[strings.ToLower(s[i:])]; ok && err == nil && value >= 0 {
+			return value, unit, nil
+		}
+	}
+	return 0, "", fmt.Errorf("invalid weight %q: expected e.g. \"2 kg\" (units: g, kg, oz, lb)", s)
+}
+
+// conditions parses the rate's weight and price bounds.
+func (r shippingRateSpec) conditions(currency string) ([]rateCondition, error) {
+	bounds := []struct{ name, value, field, operator string }{
+		{"minWeight", r.MinWeight, "TOTAL_WEIGHT", "GREATER_THAN_OR_EQUAL_TO"},
+		{"maxWeight", r.MaxWeight, "TOTAL_WEIGHT", "LESS_THAN_OR_EQUAL_TO"},
+		{"minPrice", r.MinPrice, "TOTAL_PRICE", "GREATER_THAN_OR_EQUAL_TO"},
+		{"maxPrice", r.MaxPrice, "TOTAL_PRICE", "LESS_THAN_OR_EQUAL_TO"},
+	}
+	var conds []rateCondition
+	for _, b := range bounds {
+		if b.value == "" {
+			continue
+		}
+		c := rateCondition{field: b.field, operator: b.operator, unit: currency}
+		var err error
+		if b.field == "TOTAL_WEIGHT" {
+			c.value, c.unit, err = parseWeight(b.value)
+		} else if c.value, err = strconv.ParseFloat(b.value, 64); err != nil || c.value < 0 {
+			err = fmt.Errorf("invalid amount %q", b.value)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("rate %q %s: %w", r.Name, b.name, err)
+		}
+		conds = append(conds, c)
+	}
+	return conds, nil
+}
+
+// addConditionInputs adds weightConditionsToCreate and priceConditionsToCreate
+// to a DeliveryMethodDefinitionInput.
+func addConditionInputs(in map[string]any, conds []rateCondition) {
+	var weight, price []map[string]any
+	for _, c := range conds {
+		if c.field == "TOTAL_WEIGHT" {
+			weight = append(weight, map[string]any{
+				"operator": c.operator,
+				"criteria": map[string]any{"value": c.value, "unit": c.unit},
+			})
+		} else {
+			price = append(price, map[string]any{
+				"operator": c.operator,
+				"criteria": map[string]any{"amount": fmt.Sprintf("%.2f", c.value), "currencyCode": c.unit},
+			})
+		}
+	}
+	if len(weight) > 0 {
+		in["weightConditionsToCreate"] = weight
+	}
+	if len(price) > 0 {
+		in["priceConditionsToCreate"] = price
+	}
+}
+
+// shippingPlan accumulates the diff and the DeliveryProfileInput that applies it.
+type shippingPlan struct {
+	changes                   []shippingChange
+	zonesToDelete             []string
+	methodDefinitionsToDelete []string
+	conditionsToDelete        []string
+}
+
+// planShippingProfile compares a profile with its file and returns the changes
+// and the deliveryProfileUpdate input. Zones and flat rates missing from the file
+// are deleted; carrier-calculated rates are left alone.
+func planShippingProfile(p *api.DeliveryProfile, spec shippingSpec, currency string) ([]shippingChange, map[string]any, error) {
+	plan := &shippingPlan{}
+	var groups []map[string]any
+	seen := map[string]bool{}
+	for _, gs := range spec.LocationGroups {
+		g, err := findLocationGroup(p, gs.ID)
+		if err != nil {
+			return nil, nil, err
+		}
+		if seen[g.LocationGroup.ID] {
+			return nil, nil, fmt.Errorf("location group %s is listed twice", shortID(g.LocationGroup.ID))
+		}
+		seen[g.LocationGroup.ID] = true
+		input, err := plan.group(g, gs, currency)
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(input) > 1 {
+			groups = append(groups, input)
+		}
+	}
+
+	profile := map[string]any{}
+	if len(groups) > 0 {
+		profile["profileLocationGroups"] = groups
+	}
+	if len(plan.zonesToDelete) > 0 {
+		profile["zonesToDelete"] = plan.zonesToDelete
+	}
+	if len(plan.methodDefinitionsToDelete) > 0 {
+		profile["methodDefinitionsToDelete"] = plan.methodDefinitionsToDelete
+	}
+	if len(plan.conditionsToDelete) > 0 {
+		profile["conditionsToDelete"] = plan.conditionsToDelete
+	}
+	return plan.changes, profile, nil
+}
+
+// findLocationGroup picks the location group a file entry refers to. The ID may
+// be omitted when the profile has a single location group.
+func findLocationGroup(p *api.DeliveryProfile, id string) (*api.DeliveryProfileLocationGroup, error) {
+	if id == "" {
+		if len(p.ProfileLocationGroups) != 1 {
+			return nil, fmt.Errorf("profile %q has %d location groups — set id on each entry in locationGroups", p.Name, len(p.ProfileLocationGroups))
+		}
+		return &p.ProfileLocationGroups[0], nil
+	}
+	for i, g := range p.ProfileLocationGroups {
+		if g.LocationGroup.ID == id || shortID(g.LocationGroup.ID) == id {
+			return &p.ProfileLocationGroups[i], nil
+		}
+	}
+	return nil, fmt.Errorf("location group %s not found in profile %q", id, p.Name)
+}
+
+// group plans one location group and returns its DeliveryProfileLocationGroupInput.
+func (plan *shippingPlan) group(g *api.DeliveryProfileLocationGroup, gs shippingGroupSpec, currency string) (map[string]any, error) {
+	current := map[string]*api.DeliveryLocationGroupZone{}
+	for i := range g.LocationGroupZones.Edges {
+		z := &g.LocationGroupZones.Edges[i].Node
+		current[strings.ToLower(z.Zone.Name)] = z
+	}
+
+	var zonesToCreate, zonesToUpdate []map[string]any
+	listed := map[string]bool{}
+	for _, zs := range gs.Zones {
+		key := strings.ToLower(zs.Name)
+		switch {
+		case zs.Name == "":
+			return nil, fmt.Errorf("every zone needs a name")
+		case listed[key]:
+			return nil, fmt.Errorf("zone %q is listed twice", zs.Name)
+		case len(zs.Countries) == 0:
+			return nil, fmt.Errorf("zone %q has no countries", zs.Name)
+		}
+		listed[key] = true
+
+		countries := make([]zoneCountry, len(zs.Countries))
+		countryInputs := make([]map[string]any, len(zs.Countries))
+		labels := make([]string, len(zs.Countries))
+		for i, s := range zs.Countries {
+			c, err := parseZoneCountry(s)
+			if err != nil {
+				return nil, fmt.Errorf("zone %q: %w", zs.Name, err)
+			}
+			countries[i], countryInputs[i], labels[i] = c, c.input(), c.String()
+		}
+
+		z, ok := current[key]
+		if !ok {
+			plan.changes = append(plan.changes, shippingChange{Op: "create", Kind: "zone", Zone: zs.Name, Detail: strings.Join(labels, ", ")})
+			rates, err := plan.rates(nil, zs, currency)
+			if err != nil {
+				return nil, err
+			}
+			zone := map[string]any{"name": zs.Name, "countries": countryInputs}
+			if len(rates) > 0 {
+				zone["methodDefinitionsToCreate"] = rates
+			}
+			zonesToCreate = append(zonesToCreate, zone)
+			continue
+		}
+
+		zone := map[string]any{"id": z.Zone.ID}
+		if countriesChanged(z.Zone, countries) {
+			plan.changes = append(plan.changes, shippingChange{
+				Op: "update", Kind: "zone", Zone: zs.Name,
+				Detail: fmt.Sprintf("countries %s → %s", orDash(formatZoneCountries(z.Zone)), strings.Join(labels, ", ")),
+			})
+			zone["countries"] = countryInputs
+		}
+		if zs.Name != z.Zone.Name {
+			plan.changes = append(plan.changes, shippingChange{Op: "update", Kind: "zone", Zone: zs.Name, Detail: fmt.Sprintf("name %q → %q", z.Zone.Name, zs.Name)})
+			zone["name"] = zs.Name
+		}
+		rates, err := plan.rates(z, zs, currency)
+		if err != nil {
+			return nil, err
+		}
+		var toCreate, toUpdate []map[string]any
+		for _, in := range rates {
+			if _, ok := in["id"]; ok {
+				toUpdate = append(toUpdate, in)
+			} else {
+				toCreate = append(toCreate, in)
+			}
+		}
+		if len(toCreate) > 0 {
+			zone["methodDefinitionsToCreate"] = toCreate
+		}
+		if len(toUpdate) > 0 {
+			zone["methodDefinitionsToUpdate"] = toUpdate
+		}
+		if len(zone) > 1 {
+			zonesToUpdate = append(zonesToUpdate, zone)
+		}
+	}
+
+	for _, e := range g.LocationGroupZones.Edges {
+		if !listed[strings.ToLower(e.Node.Zone.Name)] {
+			plan.changes = append(plan.changes, shippingChange{Op: "delete", Kind: "zone", Zone: e.Node.Zone.Name})
+			plan.zonesToDelete = append(plan.zonesToDelete, e.Node.Zone.ID)
+		}
+	}
+
+	input := map[string]any{"id": g.LocationGroup.ID}
+	if len(zonesToCreate) > 0 {
+		input["zonesToCreate"] = zonesToCreate
+	}
+	if len(zonesToUpdate) > 0 {
+		input["zonesToUpdate"] = zonesToUpdate
+	}
+	return input, nil
+}
+
+// rates plans a zone's rates and returns the DeliveryMethodDefinitionInputs to
+// send: new rates without an id, changed rates with one. z is nil for a new zone.
+func (plan *shippingPlan) rates(z *api.DeliveryLocationGroupZone, zs shippingZoneSpec, currency string) ([]map[string]any, error) {
+	current := map[string]api.DeliveryMethodDefinition{}
+	if z != nil {
+		for _, e := range z.MethodDefinitions.Edges {
+			current[strings.ToLower(e.Node.Name)] = e.Node
+		}
+	}
+
+	var inputs []map[string]any
+	listed := map[string]bool{}
+	for _, r := range zs.Rates {
+		key := strings.ToLower(r.Name)
+		switch {
+		case r.Name == "":
+			return nil, fmt.Errorf("zone %q: every rate needs a name", zs.Name)
+		case listed[key]:
+			return nil, fmt.Errorf("zone %q: rate %q is listed twice", zs.Name, r.Name)
+		}
+		listed[key] = true
+		price, err := strconv.ParseFloat(strings.TrimSpace(r.Price), 64)
+		if err != nil || price < 0 {
+			return nil, fmt.Errorf("zone %q: rate %q needs a price (0 for free shipping)", zs.Name, r.Name)
+		}
+		conds, err := r.conditions(currency)
+		if err != nil {
+			return nil, fmt.Errorf("zone %q: %w", zs.Name, err)
+		}
+		active := r.Active == nil || *r.Active
+		amount := fmt.Sprintf("%.2f", price)
+		rateDefinition := map[string]any{"price": map[string]any{"amount": amount, "currencyCode": currency}}
+		in := map[string]any{
+			"name":           r.Name,
+			"description":    r.Description,
+			"active":         active,
+			"rateDefinition": rateDefinition,
+		}
+
+		md, ok := current[key]
+		if !ok {
+			detail := formatMoney(amount, currency)
+			if len(conds) > 0 {
+				detail += " (" + formatConditions(conds) + ")"
+			}
+			plan.changes = append(plan.changes, shippingChange{Op: "create", Kind: "rate", Zone: zs.Name, Rate: r.Name, Detail: detail})
+			addConditionInputs(in, conds)
+			inputs = append(inputs, in)
+			continue
+		}
+		if md.RateProvider.Price == nil {
+			return nil, fmt.Errorf("zone %q: rate %q is calculated by a carrier service and can't be set from a profile file", zs.Name, r.Name)
+		}
+
+		var diffs []string
+		was := formatMoney(fmt.Sprintf("%.2f", parseAmount(md.RateProvider.Price.Amount)), md.RateProvider.Price.CurrencyCode)
+		if now := formatMoney(amount, currency); was != now {
+			diffs = append(diffs, "price "+was+" → "+now)
+		}
+		if md.Name != r.Name {
+			diffs = append(diffs, fmt.Sprintf("name %q → %q", md.Name, r.Name))
+		}
+		if md.Description != r.Description {
+			diffs = append(diffs, fmt.Sprintf("description %q → %q", md.Description, r.Description))
+		}
+		if md.Active != active {
+			diffs = append(diffs, "active "+yesNo(md.Active)+" → "+yesNo(active))
+		}
+		if currentConds := rateConditions(md); !sameConditions(currentConds, conds) {
+			diffs = append(diffs, fmt.Sprintf("conditions %s → %s", orDash(formatConditions(currentConds)), orDash(formatConditions(conds))))
+			for _, c := range md.MethodConditions {
+				plan.conditionsToDelete = append(plan.conditionsToDelete, c.ID)
+			}
+			addConditionInputs(in, conds)
+		}
+		if len(diffs) == 0 {
+			continue
+		}
+		plan.changes = append(plan.changes, shippingChange{Op: "update", Kind: "rate", Zone: zs.Name, Rate: r.Name, Detail: strings.Join(diffs, "; ")})
+		in["id"] = md.ID
+		rateDefinition["id"] = md.RateProvider.ID
+		inputs = append(inputs, in)
+	}
+
+	if z != nil {
+		for _, e := range z.MethodDefinitions.Edges {
+			md := e.Node
+			if listed[strings.ToLower(md.Name)] || md.RateProvider.Price == nil {
+				continue
+			}
+			plan.changes = append(plan.changes, shippingChange{Op: "delete", Kind: "rate", Zone: zs.Name, Rate: md.Name})
+			plan.methodDefinitionsToDelete = append(plan.methodDefinitionsToDelete, md.ID)
+		}
+	}
+	return inputs, nil
+}
+
+// gramsPerUnit converts WeightUnit values to grams, so that "2 kg" in a file
+// matches a condition Shopify reports as 2000 GRAMS.
+var gramsPerUnit = map[string]float64{
+	"GRAMS":     1,
+	"KILOGRAMS": 1000,
+	"OUNCES":    28.349523125,
+	"POUNDS":    453.59237,
+}
+
+// sameConditions reports whether two sets of rate conditions are equivalent.
+func sameConditions(a, b []rateCondition) bool {
+	keys := func(conds []rateCondition) string {
+		k := make([]string, len(conds))
+		for i, c := range conds {
+			if c.field == "TOTAL_WEIGHT" {
+				k[i] = fmt.Sprintf("%s %s %.1f", c.field, c.operator, c.value*gramsPerUnit[c.unit])
+			} else {
+				k[i] = fmt.Sprintf("%s %s %.2f %s", c.field, c.operator, c.value, c.unit)
+			}
+		}
+		sort.Strings(k)
+		return strings.Join(k, "\n")
+	}
+	return keys(a) == keys(b)
+}
+
+var shippingProfilesApplyCmd = &cobra.Command{
+	Use:   "apply <profile.yaml>",
+	Short: "Update a shipping profile's zones and rates from a YAML file",
+	Long: `Make a shipping profile's zones and flat rates match a YAML (or JSON) file. The
+changes are shown as a diff and confirmed before anything is updated.
+
+  profile: "General profile"   # name or ID; the default profile if omitted
+  currency: USD                # rate currency; the shop currency if omitted
+  locationGroups:
+    - id: 1234567890           # optional when the profile has one location group
+      zones:
+        - name: Domestic
+          countries: [US]
+          rates:
+            - name: Standard
+              price: 5.00
+              maxWeight: 2 kg
+            - name: Heavy
+              price: 15.00
+              minWeight: 2 kg
+            - name: Free shipping
+              price: 0
+              minPrice: 75
+        - name: Canada & Europe
+          countries: ["CA:ON,BC", DE, FR]   # CODE:PROVINCE,... limits a country to provinces
+          rates:
+            - name: International
+              price: 20.00
+              description: 5-10 business days
+
+Countries without provinces include the whole country; REST_OF_WORLD adds the
+rest-of-world zone. A zone limited to some provinces of AU, BR, CA, or US is
+widened when the file lists the country without provinces; for other countries,
+list the provinces to change them. Rate conditions are minWeight, maxWeight (g,
+kg, oz, lb), minPrice, and maxPrice. Rates are inactive with active: false.
+
+Within each listed location group, zones and flat rates missing from the file
+are deleted. Carrier-calculated rates are left untouched. Location groups not in
+the file are not changed.
+
+Examples:
+  shopify-admin shipping profiles get --json > current.json
+  shopify-admin shipping profiles apply profile.yaml --dry-run
+  shopify-admin shipping profiles apply profile.yaml --yes`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var spec shippingSpec
+		if err := loadSpecFile(args[0], &spec); err != nil {
+			return err
+		}
+		if len(spec.LocationGroups) == 0 {
+			return fmt.Errorf("%s has no locationGroups", args[0])
+		}
+		currency := strings.ToUpper(spec.Currency)
+		if currency == "" {
+			shop, err := client.GetShop()
+			if err != nil {
+				return err
+			}
+			currency = shop.CurrencyCode
+		}
+		id, err := findDeliveryProfile(spec.Profile)
+		if err != nil {
+			return err
+		}
+		p, err := client.GetDeliveryProfile(id)
+		if err != nil {
+			return err
+		}
+		changes, input, err := planShippingProfile(p, spec, currency)
+		if err != nil {
+			return err
+		}
+
+		result := map[string]any{"profile": p.ID, "dryRun": shippingApplyDryRun, "applied": false, "changes": changes}
+		if changes == nil {
+			result["changes"] = []shippingChange{}
+		}
+		if !output.IsJSON(cmd) {
+			if len(changes) == 0 {
+				fmt.Printf("Shipping profile %q is already up to date.\n", p.Name)
+				return nil
+			}
+			fmt.Printf("Shipping profile %q:\n", p.Name)
+			for _, c := range changes {
+				fmt.Println("  " + c.String())
+			}
+			fmt.Println()
+		}
+		if len(changes) == 0 || shippingApplyDryRun {
+			if output.IsJSON(cmd) {
+				return output.PrintJSON(result, output.IsPretty(cmd))
+			}
+			fmt.Printf("Dry run: %d changes not applied.\n", len(changes))
+			return nil
+		}
+		if !shippingApplyYes {
+			ok, err := confirm(fmt.Sprintf("Apply %d changes to %q?", len(changes), p.Name))
+			if err != nil {
+				return err
+			}
+			if !ok {
+				fmt.Fprintln(os.Stderr, "Aborted; the profile was not changed.")
+				return nil
+			}
+		}
+		if err := client.UpdateDeliveryProfile(p.ID, input); err != nil {
+			return err
+		}
+		if output.IsJSON(cmd) {
+			result["applied"] = true
+			return output.PrintJSON(result, output.IsPretty(cmd))
+		}
+		fmt.Printf("Applied %d changes to %q.\n", len(changes), p.Name)
+		return nil
+	},
+}
+
+func init() {
+	shippingProfilesApplyCmd.Flags().BoolVar(&shippingApplyDryRun, "dry-run", false, "Show the diff without changing anything")
+	shippingProfilesApplyCmd.Flags().BoolVar(&shippingApplyYes, "yes", false, "Apply without asking for confirmation")
+
+	shippingProfilesCmd.AddCommand(shippingProfilesApplyCmd)
+}
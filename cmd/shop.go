@@ -1,6 +1,8 @@
 package cmd
 
 import (
+	"fmt"
+
 	"github.com/spf13/cobra"
 	"github.com/the20100/shopify-admin-cli/internal/output"
 )
@@ -46,7 +48,37 @@ Examples:
 	},
 }
 
+var shopLocalesCmd = &cobra.Command{
+	Use:   "locales",
+	Short: "List the store's enabled locales",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		locales, err := client.ListShopLocales()
+		if err != nil {
+			return err
+		}
+		if output.IsJSON(cmd) {
+			return output.PrintJSON(locales, output.IsPretty(cmd))
+		}
+		if len(locales) == 0 {
+			fmt.Println("No locales found.")
+			return nil
+		}
+		headers := []string{"LOCALE", "NAME", "PRIMARY", "PUBLISHED"}
+		rows := make([][]string, len(locales))
+		for i, l := range locales {
+			rows[i] = []string{
+				l.Locale,
+				l.Name,
+				output.FormatBool(l.Primary),
+				output.FormatBool(l.Published),
+			}
+		}
+		output.PrintTable(headers, rows)
+		return nil
+	},
+}
+
 func init() {
-	shopCmd.AddCommand(shopInfoCmd)
+	shopCmd.AddCommand(shopInfoCmd, shopLocalesCmd)
 	rootCmd.AddCommand(shopCmd)
 }
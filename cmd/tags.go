@@ -0,0 +1,215 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/spf13/cobra"
+	"github.com/the20100/shopify-admin-cli/internal/api"
+	"github.com/the20100/shopify-admin-cli/internal/output"
+)
+
+var tagsCmd = &cobra.Command{
+	Use:   "tags",
+	Short: "Add, remove, and list tags on products, orders, customers, and draft orders",
+}
+
+// ---- tags add / remove ----
+
+var (
+	tagsAddQuery    string
+	tagsRemoveQuery string
+)
+
+var tagsAddCmd = &cobra.Command{
+	Use:   "add <resource> <id|--query> <tags>",
+	Short: "Add tags without replacing existing ones",
+	Long: `Add tags to one resource, or to every resource matching --query.
+Existing tags are kept — unlike --tags on update commands, which replaces the whole list.
+
+Resources: products, orders, customers, draft-orders
+
+Examples:
+  shopify-admin tags add products 1234567890 "sale,summer"
+  shopify-admin tags add orders --query "financial_status:paid created_at:>2024-06-01" reviewed
+  shopify-admin tags add customers 1234567890 vip`,
+	Args: cobra.RangeArgs(2, 3),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runTagsChange(cmd, args, tagsAddQuery, true)
+	},
+}
+
+var tagsRemoveCmd = &cobra.Command{
+	Use:   "remove <resource> <id|--query> <tags>",
+	Short: "Remove tags, leaving other tags in place",
+	Long: `Remove tags from one resource, or from every resource matching --query.
+
+Resources: products, orders, customers, draft-orders
+
+Examples:
+  shopify-admin tags remove products 1234567890 sale
+  shopify-admin tags remove products --query "tag:summer" "summer,clearance"`,
+	Args: cobra.RangeArgs(2, 3),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runTagsChange(cmd, args, tagsRemoveQuery, false)
+	},
+}
+
+// runTagsChange applies tagsAdd or tagsRemove to a single ID or to all resources matching query.
+func runTagsChange(cmd *cobra.Command, args []string, query string, add bool) error {
+	resource, err := api.LookupTaggableResource(args[0])
+	if err != nil {
+		return err
+	}
+	var ids []string
+	var tagArg string
+	switch {
+	case query != "" && len(args) == 2:
+		tagArg = args[1]
+		ids, err = collectTaggedIDs(resource, query)
+		if err != nil {
+			return err
+		}
+	case query == "" && len(args) == 3:
+		id, err := resolveID(resource.GIDType, args[1])
+		if err != nil {
+			return err
+		}
+		ids = []string{id}
+		tagArg = args[2]
+	default:
+		return fmt.Errorf("pass either an <id> or --query, followed by comma-separated tags")
+	}
+	tags := splitTags(tagArg)
+	if len(tags) == 0 {
+		return fmt.Errorf("no tags given")
+	}
+
+	verb := "added to"
+	if !add {
+		verb = "removed from"
+	}
+	var updated []string
+	for _, id := range ids {
+		if add {
+			err = client.AddTags(resource, id, tags)
+		} else {
+			err = client.RemoveTags(resource, id, tags)
+		}
+		if err != nil {
+			return fmt.Errorf("%s %s: %w", resource.GIDType, shortID(id), err)
+		}
+		updated = append(updated, api.ToGID(resource.GIDType, id))
+		if len(ids) > 1 && !output.IsJSON(cmd) {
+			fmt.Fprintf(os.Stderr, "[%d/%d] %s\n", len(updated), len(ids), shortID(id))
+		}
+	}
+
+	if output.IsJSON(cmd) {
+		return output.PrintJSON(map[string]any{"tags": tags, "ids": updated}, output.IsPretty(cmd))
+	}
+	fmt.Printf("Tags %s %s %d %s.\n", output.FormatLabels(tags), verb, len(updated), resource.Name)
+	return nil
+}
+
+// collectTaggedIDs pages through every resource matching query and returns their IDs.
+func collectTaggedIDs(resource api.TaggableResource, query string) ([]string, error) {
+	var ids []string
+	after := ""
+	for {
+		conn, err := client.ListTagged(resource, 250, after, query)
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range conn.Edges {
+			ids = append(ids, e.Node.ID)
+		}
+		if !conn.PageInfo.HasNextPage {
+			return ids, nil
+		}
+		after = conn.PageInfo.EndCursor
+	}
+}
+
+// ---- tags list ----
+
+var tagsListQuery string
+
+type tagCount struct {
+	Tag   string `json:"tag"`
+	Count int    `json:"count"`
+}
+
+var tagsListCmd = &cobra.Command{
+	Use:   "list <resource>",
+	Short: "List distinct tags with usage counts",
+	Long: `List every distinct tag used on a resource type, with the number of resources using it.
+Scans all resources (or those matching --query), so it can take a while on large stores.
+
+Resources: products, orders, customers, draft-orders
+
+Examples:
+  shopify-admin tags list products
+  shopify-admin tags list orders --query "created_at:>2024-01-01"
+  shopify-admin tags list customers --json`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		resource, err := api.LookupTaggableResource(args[0])
+		if err != nil {
+			return err
+		}
+		counts := map[string]int{}
+		after := ""
+		for {
+			conn, err := client.ListTagged(resource, 250, after, tagsListQuery)
+			if err != nil {
+				return err
+			}
+			for _, e := range conn.Edges {
+				for _, t := range e.Node.Tags {
+					counts[t]++
+				}
+			}
+			if !conn.PageInfo.HasNextPage {
+				break
+			}
+			after = conn.PageInfo.EndCursor
+		}
+
+		result := make([]tagCount, 0, len(counts))
+		for t, n := range counts {
+			result = append(result, tagCount{Tag: t, Count: n})
+		}
+		sort.Slice(result, func(i, j int) bool {
+			if result[i].Count != result[j].Count {
+				return result[i].Count > result[j].Count
+			}
+			return result[i].Tag < result[j].Tag
+		})
+
+		if output.IsJSON(cmd) {
+			return output.PrintJSON(result, output.IsPretty(cmd))
+		}
+		if len(result) == 0 {
+			fmt.Printf("No tags found on %s.\n", resource.Name)
+			return nil
+		}
+		headers := []string{"TAG", "COUNT"}
+		rows := make([][]string, len(result))
+		for i, tc := range result {
+			rows[i] = []string{tc.Tag, fmt.Sprintf("%d", tc.Count)}
+		}
+		output.PrintTable(headers, rows)
+		return nil
+	},
+}
+
+func init() {
+	tagsAddCmd.Flags().StringVar(&tagsAddQuery, "query", "", "Apply to every resource matching this search query")
+	tagsRemoveCmd.Flags().StringVar(&tagsRemoveQuery, "query", "", "Apply to every resource matching this search query")
+	tagsListCmd.Flags().StringVar(&tagsListQuery, "query", "", "Only count tags on resources matching this search query")
+
+	tagsCmd.AddCommand(tagsAddCmd, tagsRemoveCmd, tagsListCmd)
+	rootCmd.AddCommand(tagsCmd)
+}
@@ -0,0 +1,305 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/the20100/shopify-admin-cli/internal/api"
+	"github.com/the20100/shopify-admin-cli/internal/output"
+)
+
+var translationsCmd = &cobra.Command{
+	Use:   "translations",
+	Short: "Export and import translations for products, collections, metaobjects, and metafields",
+}
+
+// translatableTypes maps CLI resource names to TranslatableResourceType values.
+var translatableTypes = map[string]string{
+	"product":    "PRODUCT",
+	"collection": "COLLECTION",
+	"metaobject": "METAOBJECT",
+	"metafield":  "METAFIELD",
+}
+
+var translationCSVHeaders = []string{"resource_id", "key", "locale", "digest", "source", "translation", "outdated"}
+
+func translatableType(resource string) (string, error) {
+	key := strings.TrimSuffix(strings.ToLower(resource), "s")
+	if t, ok := translatableTypes[key]; ok {
+		return t, nil
+	}
+	return "", fmt.Errorf("unknown resource %q — available: product, collection, metaobject, metafield", resource)
+}
+
+// translationRow is one translatable key of a resource paired with its translation (if any).
+type translationRow struct {
+	ResourceID  string `json:"resourceId"`
+	Key         string `json:"key"`
+	Locale      string `json:"locale"`
+	Digest      string `json:"digest"`
+	Source      string `json:"source"`
+	Translation string `json:"translation"`
+	Outdated    bool   `json:"outdated"`
+}
+
+// fetchTranslationRows pages through all translatable resources of a type and flattens
+// them into one row per translatable key.
+func fetchTranslationRows(resourceType, locale string) ([]translationRow, error) {
+	var rows []translationRow
+	after := ""
+	for {
+		conn, err := client.ListTranslatableResources(resourceType, locale, 250, after)
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range conn.Edges {
+			r := e.Node
+			byKey := make(map[string]api.Translation, len(r.Translations))
+			for _, t := range r.Translations {
+				byKey[t.Key] = t
+			}
+			for _, tc := range r.TranslatableContent {
+				t := byKey[tc.Key]
+				rows = append(rows, translationRow{
+					ResourceID:  r.ResourceID,
+					Key:         tc.Key,
+					Locale:      locale,
+					Digest:      tc.Digest,
+					Source:      tc.Value,
+					Translation: t.Value,
+					Outdated:    t.Outdated,
+				})
+			}
+		}
+		if !conn.PageInfo.HasNextPage {
+			return rows, nil
+		}
+		after = conn.PageInfo.EndCursor
+	}
+}
+
+// ---- translations export ----
+
+var (
+	translationsExportResource string
+	translationsExportLocale   string
+	translationsExportOutput   string
+)
+
+var translationsExportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export translatable keys and current translations as CSV",
+	Long: `Export every translatable key of a resource type as CSV, with the source text,
+its digest, and the current translation for --locale.
+
+Columns: resource_id, key, locale, digest, source, translation, outdated
+
+Fill in the translation column and feed the file back with 'translations import'.
+Keep the digest column as-is: Shopify uses it to detect source text that changed
+after the export.
+
+Resources: product, collection, metaobject, metafield
+
+Examples:
+  shopify-admin translations export --resource product --locale fr > products-fr.csv
+  shopify-admin translations export --resource collection --locale de --output collections-de.csv`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if translationsExportLocale == "" {
+			return fmt.Errorf("--locale is required")
+		}
+		resourceType, err := translatableType(translationsExportResource)
+		if err != nil {
+			return err
+		}
+		rows, err := fetchTranslationRows(resourceType, translationsExportLocale)
+		if err != nil {
+			return err
+		}
+		var w io.Writer = os.Stdout
+		if translationsExportOutput != "" {
+			f, err := os.Create(translationsExportOutput)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			w = f
+		}
+		records := make([][]string, len(rows))
+		outdated := 0
+		for i, r := range rows {
+			records[i] = []string{r.ResourceID, r.Key, r.Locale, r.Digest, r.Source, r.Translation, strconv.FormatBool(r.Outdated)}
+			if r.Outdated {
+				outdated++
+			}
+		}
+		if err := output.WriteCSV(w, translationCSVHeaders, records); err != nil {
+			return err
+		}
+		fmt.Fprintf(os.Stderr, "Exported %d keys (%d outdated).\n", len(rows), outdated)
+		return nil
+	},
+}
+
+// ---- translations import ----
+
+var translationsImportDryRun bool
+
+var translationsImportCmd = &cobra.Command{
+	Use:   "import <file.csv>",
+	Short: "Register translations from a CSV file",
+	Long: `Register translations from a CSV file in the 'translations export' format.
+
+Required columns: resource_id, key, locale, digest, translation
+Rows with an empty translation are skipped.
+
+Examples:
+  shopify-admin translations import products-fr.csv
+  shopify-admin translations import products-fr.csv --dry-run`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		records, err := readCSVFile(args[0], "translation")
+		if err != nil {
+			return err
+		}
+		if err := requireColumns(records, args[0], "resource_id", "key", "locale", "digest", "translation"); err != nil {
+			return err
+		}
+
+		// Group rows by resource, preserving file order.
+		var order []string
+		byResource := map[string][]api.TranslationInput{}
+		skipped := 0
+		for _, rec := range records {
+			if rec["translation"] == "" {
+				skipped++
+				continue
+			}
+			id := rec["resource_id"]
+			if _, ok := byResource[id]; !ok {
+				order = append(order, id)
+			}
+			byResource[id] = append(byResource[id], api.TranslationInput{
+				Key:    rec["key"],
+				Locale: rec["locale"],
+				Value:  rec["translation"],
+				Digest: rec["digest"],
+			})
+		}
+
+		type importResult struct {
+			ResourceID string `json:"resourceId"`
+			Keys       int    `json:"keys"`
+			Error      string `json:"error,omitempty"`
+		}
+		results := make([]importResult, 0, len(order))
+		registered, failed := 0, 0
+		for _, id := range order {
+			inputs := byResource[id]
+			res := importResult{ResourceID: id, Keys: len(inputs)}
+			if !translationsImportDryRun {
+				if _, err := client.RegisterTranslations(id, inputs); err != nil {
+					res.Error = err.Error()
+					failed++
+				} else {
+					registered += len(inputs)
+				}
+			}
+			results = append(results, res)
+		}
+
+		if output.IsJSON(cmd) {
+			return output.PrintJSON(results, output.IsPretty(cmd))
+		}
+		for _, r := range results {
+			if r.Error != "" {
+				fmt.Printf("%s: %s\n", r.ResourceID, r.Error)
+			}
+		}
+		if translationsImportDryRun {
+			total := 0
+			for _, r := range results {
+				total += r.Keys
+			}
+			fmt.Printf("Dry run: would register %d translations on %d resources (%d empty rows skipped).\n", total, len(results), skipped)
+			return nil
+		}
+		fmt.Printf("Registered %d translations on %d resources (%d empty rows skipped).\n", registered, len(results)-failed, skipped)
+		if failed > 0 {
+			return fmt.Errorf("%d resources failed", failed)
+		}
+		return nil
+	},
+}
+
+// ---- translations outdated ----
+
+var (
+	translationsOutdatedResource string
+	translationsOutdatedLocale   string
+)
+
+var translationsOutdatedCmd = &cobra.Command{
+	Use:   "outdated",
+	Short: "List translations whose source text changed since they were written",
+	Long: `List translated keys whose source text has changed since the translation was registered.
+
+Examples:
+  shopify-admin translations outdated --resource product --locale fr
+  shopify-admin translations outdated --resource metaobject --locale de --json`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if translationsOutdatedLocale == "" {
+			return fmt.Errorf("--locale is required")
+		}
+		resourceType, err := translatableType(translationsOutdatedResource)
+		if err != nil {
+			return err
+		}
+		rows, err := fetchTranslationRows(resourceType, translationsOutdatedLocale)
+		if err != nil {
+			return err
+		}
+		outdated := make([]translationRow, 0)
+		for _, r := range rows {
+			if r.Outdated {
+				outdated = append(outdated, r)
+			}
+		}
+		if output.IsJSON(cmd) {
+			return output.PrintJSON(outdated, output.IsPretty(cmd))
+		}
+		if len(outdated) == 0 {
+			fmt.Println("No outdated translations found.")
+			return nil
+		}
+		headers := []string{"RESOURCE", "KEY", "SOURCE", "TRANSLATION"}
+		table := make([][]string, len(outdated))
+		for i, r := range outdated {
+			table[i] = []string{
+				r.ResourceID,
+				r.Key,
+				output.Truncate(r.Source, 40),
+				output.Truncate(r.Translation, 40),
+			}
+		}
+		output.PrintTable(headers, table)
+		return nil
+	},
+}
+
+func init() {
+	translationsExportCmd.Flags().StringVar(&translationsExportResource, "resource", "product", "Resource type: product, collection, metaobject, metafield")
+	translationsExportCmd.Flags().StringVar(&translationsExportLocale, "locale", "", "Target locale, e.g. fr (required)")
+	translationsExportCmd.Flags().StringVar(&translationsExportOutput, "output", "", "Write CSV to this file instead of stdout")
+
+	translationsImportCmd.Flags().BoolVar(&translationsImportDryRun, "dry-run", false, "Validate the file and show what would be registered")
+
+	translationsOutdatedCmd.Flags().StringVar(&translationsOutdatedResource, "resource", "product", "Resource type: product, collection, metaobject, metafield")
+	translationsOutdatedCmd.Flags().StringVar(&translationsOutdatedLocale, "locale", "", "Target locale, e.g. fr (required)")
+
+	translationsCmd.AddCommand(translationsExportCmd, translationsImportCmd, translationsOutdatedCmd)
+	rootCmd.AddCommand(translationsCmd)
+}
@@ -102,7 +102,11 @@ var webhooksDeleteCmd = &cobra.Command{
 	Short: "Delete a webhook subscription",
 	Args:  cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
-		if err := client.DeleteWebhook(args[0]); err != nil {
+		id, err := resolveID("WebhookSubscription", args[0])
+		if err != nil {
+			return err
+		}
+		if err := client.DeleteWebhook(id); err != nil {
 			return err
 		}
 		fmt.Printf("Webhook %s deleted.\n", args[0])
@@ -0,0 +1,56 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// ListAbandonedCheckouts returns a paginated list of abandoned checkouts, newest
+// first, with their customer and line items. Each checkout costs about 70 points,
+// so keep first at 10 or less.
+func (c *Client) ListAbandonedCheckouts(first int, after, query string) (*AbandonedCheckoutConnection, error) {
+	const gql = `
+		query ListAbandonedCheckouts($first: Int!, $after: String, $query: String) {
+			abandonedCheckouts(first: $first, after: $after, query: $query, sortKey: CREATED_AT, reverse: true) {
+				edges {
+					cursor
+					node {
+						id name createdAt updatedAt completedAt abandonedCheckoutUrl
+						subtotalPriceSet { shopMoney { amount currencyCode } }
+						totalPriceSet { shopMoney { amount currencyCode } }
+						customer {
+							id firstName lastName email
+							lastOrder { id name createdAt }
+						}
+						lineItems(first: 20) {
+							edges {
+								node {
+									id title variantTitle sku quantity
+									originalTotalPriceSet { shopMoney { amount currencyCode } }
+								}
+							}
+						}
+					}
+				}
+				pageInfo { hasNextPage endCursor }
+			}
+		}`
+	vars := map[string]any{"first": first}
+	if after != "" {
+		vars["after"] = after
+	}
+	if query != "" {
+		vars["query"] = query
+	}
+	resp, err := c.Do(gql, vars)
+	if err != nil {
+		return nil, err
+	}
+	var data struct {
+		AbandonedCheckouts AbandonedCheckoutConnection `json:"abandonedCheckouts"`
+	}
+	if err := json.Unmarshal(resp.Data, &data); err != nil {
+		return nil, fmt.Errorf("parsing abandoned checkouts: %w", err)
+	}
+	return &data.AbandonedCheckouts, nil
+}
@@ -0,0 +1,148 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+const carrierServiceFields = `id name formattedName callbackUrl active supportsServiceDiscovery`
+
+// ListCarrierServices returns a paginated list of carrier-calculated shipping services.
+func (c *Client) ListCarrierServices(first int, after string) (*CarrierServiceConnection, error) {
+	gql := `
+		query ListCarrierServices($first: Int!, $after: String) {
+			carrierServices(first: $first, after: $after) {
+				edges {
+					cursor
+					node { ` + carrierServiceFields + ` }
+				}
+				pageInfo { hasNextPage endCursor }
+			}
+		}`
+	vars := map[string]any{"first": first}
+	if after != "" {
+		vars["after"] = after
+	}
+	resp, err := c.Do(gql, vars)
+	if err != nil {
+		return nil, err
+	}
+	var data struct {
+		CarrierServices CarrierServiceConnection `json:"carrierServices"`
+	}
+	if err := json.Unmarshal(resp.Data, &data); err != nil {
+		return nil, fmt.Errorf("parsing carrier services: %w", err)
+	}
+	return &data.CarrierServices, nil
+}
+
+// GetCarrierService returns a single carrier service.
+func (c *Client) GetCarrierService(id string) (*CarrierService, error) {
+	gql := `
+		query GetCarrierService($id: ID!) {
+			carrierService(id: $id) { ` + carrierServiceFields + ` }
+		}`
+	resp, err := c.Do(gql, map[string]any{"id": ToGID("DeliveryCarrierService", id)})
+	if err != nil {
+		return nil, err
+	}
+	var data struct {
+		CarrierService *CarrierService `json:"carrierService"`
+	}
+	if err := json.Unmarshal(resp.Data, &data); err != nil {
+		return nil, fmt.Errorf("parsing carrier service: %w", err)
+	}
+	if data.CarrierService == nil {
+		return nil, fmt.Errorf("carrier service %s not found", id)
+	}
+	return data.CarrierService, nil
+}
+
+// CreateCarrierService registers a rate-calculation endpoint that Shopify calls at
+// checkout to get shipping rates.
+func (c *Client) CreateCarrierService(name, callbackURL string, active, serviceDiscovery bool) (*CarrierService, error) {
+	gql := `
+		mutation carrierServiceCreate($input: DeliveryCarrierServiceCreateInput!) {
+			carrierServiceCreate(input: $input) {
+				carrierService { ` + carrierServiceFields + ` }
+				userErrors { field message }
+			}
+		}`
+	input := map[string]any{
+		"name":                     name,
+		"callbackUrl":              callbackURL,
+		"active":                   active,
+		"supportsServiceDiscovery": serviceDiscovery,
+	}
+	resp, err := c.Do(gql, map[string]any{"input": input})
+	if err != nil {
+		return nil, err
+	}
+	var data struct {
+		CarrierServiceCreate struct {
+			CarrierService *CarrierService `json:"carrierService"`
+			UserErrors     []UserError     `json:"userErrors"`
+		} `json:"carrierServiceCreate"`
+	}
+	if err := json.Unmarshal(resp.Data, &data); err != nil {
+		return nil, fmt.Errorf("parsing response: %w", err)
+	}
+	if err := userErrorsToError(data.CarrierServiceCreate.UserErrors); err != nil {
+		return nil, err
+	}
+	return data.CarrierServiceCreate.CarrierService, nil
+}
+
+// UpdateCarrierService changes the fields in input (name, callbackUrl, active,
+// supportsServiceDiscovery) and leaves the rest as they are.
+func (c *Client) UpdateCarrierService(id string, input map[string]any) (*CarrierService, error) {
+	gql := `
+		mutation carrierServiceUpdate($input: DeliveryCarrierServiceUpdateInput!) {
+			carrierServiceUpdate(input: $input) {
+				carrierService { ` + carrierServiceFields + ` }
+				userErrors { field message }
+			}
+		}`
+	input["id"] = ToGID("DeliveryCarrierService", id)
+	resp, err := c.Do(gql, map[string]any{"input": input})
+	if err != nil {
+		return nil, err
+	}
+	var data struct {
+		CarrierServiceUpdate struct {
+			CarrierService *CarrierService `json:"carrierService"`
+			UserErrors     []UserError     `json:"userErrors"`
+		} `json:"carrierServiceUpdate"`
+	}
+	if err := json.Unmarshal(resp.Data, &data); err != nil {
+		return nil, fmt.Errorf("parsing response: %w", err)
+	}
+	if err := userErrorsToError(data.CarrierServiceUpdate.UserErrors); err != nil {
+		return nil, err
+	}
+	return data.CarrierServiceUpdate.CarrierService, nil
+}
+
+// DeleteCarrierService removes a carrier service; its rates stop appearing at checkout.
+func (c *Client) DeleteCarrierService(id string) error {
+	const gql = `
+		mutation carrierServiceDelete($id: ID!) {
+			carrierServiceDelete(id: $id) {
+				deletedId
+				userErrors { field message }
+			}
+		}`
+	resp, err := c.Do(gql, map[string]any{"id": ToGID("DeliveryCarrierService", id)})
+	if err != nil {
+		return err
+	}
+	var data struct {
+		CarrierServiceDelete struct {
+			UserErrors []UserError `json:"userErrors"`
+		} `json:"carrierServiceDelete"`
+	}
+	if err := json.Unmarshal(resp.Data, &data); err != nil {
+		return fmt.Errorf("parsing response: %w", err)
+	}
+	return userErrorsToError(data.CarrierServiceDelete.UserErrors)
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strconv"
 	"strings"
 	"time"
 )
@@ -37,7 +38,12 @@ func (c *Client) endpoint() string {
 	return fmt.Sprintf("https://%s/admin/api/%s/graphql.json", shop, apiVersion)
 }
 
-// Do executes a GraphQL query or mutation.
+// maxThrottleRetries is how many times Do retries a request that Shopify throttled.
+const maxThrottleRetries = 5
+
+// Do executes a GraphQL query or mutation. Throttled requests are retried after
+// waiting for the rate-limit bucket to refill, so Do is safe to call from several
+// goroutines at once.
 func (c *Client) Do(query string, variables map[string]any) (*GraphQLResponse, error) {
 	payload := GraphQLRequest{
 		Query:     query,
@@ -49,9 +55,21 @@ func (c *Client) Do(query string, variables map[string]any) (*GraphQLResponse, e
 		return nil, fmt.Errorf("encoding request: %w", err)
 	}
 
+	for attempt := 0; ; attempt++ {
+		resp, retryAfter, err := c.send(data)
+		if retryAfter == 0 || attempt == maxThrottleRetries {
+			return resp, err
+		}
+		time.Sleep(retryAfter)
+	}
+}
+
+// send posts one request. When Shopify throttles it, send returns how long to wait
+// before retrying along with the error.
+func (c *Client) send(data []byte) (*GraphQLResponse, time.Duration, error) {
 	req, err := http.NewRequest(http.MethodPost, c.endpoint(), bytes.NewReader(data))
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
 	req.Header.Set("Content-Type", "application/json")
@@ -60,17 +78,24 @@ func (c *Client) Do(query string, variables map[string]any) (*GraphQLResponse, e
 
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("request failed: %w", err)
+		return nil, 0, fmt.Errorf("request failed: %w", err)
 	}
 	defer resp.Body.Close()
 
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, fmt.Errorf("reading response: %w", err)
+		return nil, 0, fmt.Errorf("reading response: %w", err)
 	}
 
 	if resp.StatusCode >= 400 {
-		return nil, &ShopifyError{
+		var retryAfter time.Duration
+		if resp.StatusCode == http.StatusTooManyRequests {
+			retryAfter = 2 * time.Second
+			if secs, err := strconv.ParseFloat(resp.Header.Get("Retry-After"), 64); err == nil && secs > 0 {
+				retryAfter = time.Duration(secs * float64(time.Second))
+			}
+		}
+		return nil, retryAfter, &ShopifyError{
 			StatusCode: resp.StatusCode,
 			Message:    fmt.Sprintf("HTTP %d: %s", resp.StatusCode, string(body)),
 		}
@@ -78,21 +103,42 @@ func (c *Client) Do(query string, variables map[string]any) (*GraphQLResponse, e
 
 	var gqlResp GraphQLResponse
 	if err := json.Unmarshal(body, &gqlResp); err != nil {
-		return nil, fmt.Errorf("parsing response: %w", err)
+		return nil, 0, fmt.Errorf("parsing response: %w", err)
 	}
 
 	if len(gqlResp.Errors) > 0 {
 		msgs := make([]string, len(gqlResp.Errors))
+		var retryAfter time.Duration
 		for i, e := range gqlResp.Errors {
 			msgs[i] = e.Message
+			if e.Extensions.Code == "THROTTLED" {
+				retryAfter = throttleWait(gqlResp.Extensions)
+			}
 		}
-		return nil, &ShopifyError{
+		return nil, retryAfter, &ShopifyError{
 			StatusCode: 200,
 			Message:    strings.Join(msgs, "; "),
 		}
 	}
 
-	return &gqlResp, nil
+	return &gqlResp, 0, nil
+}
+
+// throttleWait estimates how long until the rate-limit bucket holds enough points
+// for the throttled query, with a one-second floor.
+func throttleWait(ext *GraphQLExtensions) time.Duration {
+	wait := time.Second
+	if ext == nil {
+		return wait
+	}
+	status := ext.Cost.ThrottleStatus
+	if status.RestoreRate > 0 {
+		missing := ext.Cost.RequestedQueryCost - status.CurrentlyAvailable
+		if d := time.Duration(missing / status.RestoreRate * float64(time.Second)); d > wait {
+			wait = d
+		}
+	}
+	return wait
 }
 
 // ToGID converts a plain numeric ID to a Shopify GID.
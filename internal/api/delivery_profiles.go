@@ -0,0 +1,261 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// ListDeliveryProfiles returns a paginated list of shipping profiles with their
+// location, zone, country, and rate counts.
+func (c *Client) ListDeliveryProfiles(first int, after string) (*DeliveryProfileConnection, error) {
+	const gql = `
+		query ListDeliveryProfiles($first: Int!, $after: String) {
+			deliveryProfiles(first: $first, after: $after) {
+				edges {
+					cursor
+					node {
+						id name default
+						activeMethodDefinitionsCount originLocationCount
+						locationsWithoutRatesCount zoneCountryCount
+						productVariantsCount { count }
+					}
+				}
+				pageInfo { hasNextPage endCursor }
+			}
+		}`
+	vars := map[string]any{"first": first}
+	if after != "" {
+		vars["after"] = after
+	}
+	resp, err := c.Do(gql, vars)
+	if err != nil {
+		return nil, err
+	}
+	var data struct {
+		DeliveryProfiles DeliveryProfileConnection `json:"deliveryProfiles"`
+	}
+	if err := json.Unmarshal(resp.Data, &data); err != nil {
+		return nil, fmt.Errorf("parsing delivery profiles: %w", err)
+	}
+	return &data.DeliveryProfiles, nil
+}
+
+const deliveryZoneFields = `
+	id name
+	countries {
+		id name
+		code { countryCode restOfWorld }
+		provinces { id name code }
+	}`
+
+const deliveryMethodDefinitionFields = `
+	id name description active
+	rateProvider {
+		__typename
+		... on DeliveryRateDefinition {
+			id
+			price { amount currencyCode }
+		}
+		... on DeliveryParticipant {
+			id percentageOfRateFee
+			fixedFee { amount currencyCode }
+			carrierService { id formattedName }
+		}
+	}
+	methodConditions {
+		id field operator
+		conditionCriteria {
+			__typename
+			... on MoneyV2 { amount currencyCode }
+			... on Weight { value unit }
+		}
+	}`
+
+// GetDeliveryProfile returns a shipping profile with its location groups, zones,
+// countries, and rates. Zones and rates are read a few at a time to stay under the
+// query cost limit, so the profile is complete however many there are.
+func (c *Client) GetDeliveryProfile(id string) (*DeliveryProfile, error) {
+	const gql = `
+		query GetDeliveryProfile($id: ID!) {
+			deliveryProfile(id: $id) {
+				id name default
+				activeMethodDefinitionsCount originLocationCount
+				locationsWithoutRatesCount zoneCountryCount
+				productVariantsCount { count }
+				profileLocationGroups {
+					locationGroup {
+						id
+						locations(first: 10) {
+							edges { node { id name } }
+							pageInfo { hasNextPage }
+						}
+					}
+				}
+			}
+		}`
+	resp, err := c.Do(gql, map[string]any{"id": ToGID("DeliveryProfile", id)})
+	if err != nil {
+		return nil, err
+	}
+	var data struct {
+		DeliveryProfile *DeliveryProfile `json:"deliveryProfile"`
+	}
+	if err := json.Unmarshal(resp.Data, &data); err != nil {
+		return nil, fmt.Errorf("parsing delivery profile: %w", err)
+	}
+	p := data.DeliveryProfile
+	if p == nil {
+		return nil, fmt.Errorf("delivery profile %s not found", id)
+	}
+	for i := range p.ProfileLocationGroups {
+		g := &p.ProfileLocationGroups[i]
+		if err := c.loadLocationGroupZones(p.ID, g); err != nil {
+			return nil, err
+		}
+	}
+	return p, nil
+}
+
+// loadLocationGroupZones reads every zone of a location group, 5 at a time, and
+// every rate of each zone.
+func (c *Client) loadLocationGroupZones(profileID string, g *DeliveryProfileLocationGroup) error {
+	gql := `
+		query DeliveryLocationGroupZones($id: ID!, $groupId: ID!, $after: String) {
+			deliveryProfile(id: $id) {
+				profileLocationGroups(locationGroupId: $groupId) {
+					locationGroupZones(first: 5, after: $after) {
+						edges {
+							cursor
+							node {
+								zone {` + deliveryZoneFields + `}
+								methodDefinitions(first: 10) {
+									edges { node {` + deliveryMethodDefinitionFields + `} }
+									pageInfo { hasNextPage endCursor }
+								}
+							}
+						}
+						pageInfo { hasNextPage endCursor }
+					}
+				}
+			}
+		}`
+	after := ""
+	for {
+		vars := map[string]any{"id": profileID, "groupId": g.LocationGroup.ID}
+		if after != "" {
+			vars["after"] = after
+		}
+		resp, err := c.Do(gql, vars)
+		if err != nil {
+			return err
+		}
+		var data struct {
+			DeliveryProfile *struct {
+				ProfileLocationGroups []struct {
+					LocationGroupZones DeliveryLocationGroupZoneConnection `json:"locationGroupZones"`
+				} `json:"profileLocationGroups"`
+			} `json:"deliveryProfile"`
+		}
+		if err := json.Unmarshal(resp.Data, &data); err != nil {
+			return fmt.Errorf("parsing delivery zones: %w", err)
+		}
+		if data.DeliveryProfile == nil || len(data.DeliveryProfile.ProfileLocationGroups) != 1 {
+			return fmt.Errorf("location group %s not found in delivery profile %s", g.LocationGroup.ID, profileID)
+		}
+		page := data.DeliveryProfile.ProfileLocationGroups[0].LocationGroupZones
+		zoneAfter := after
+		for i := range page.Edges {
+			z := &page.Edges[i].Node
+			for z.MethodDefinitions.PageInfo.HasNextPage {
+				more, err := c.zoneMethodDefinitions(profileID, g.LocationGroup.ID, zoneAfter, z.Zone.ID, z.MethodDefinitions.PageInfo.EndCursor)
+				if err != nil {
+					return err
+				}
+				z.MethodDefinitions.Edges = append(z.MethodDefinitions.Edges, more.Edges...)
+				z.MethodDefinitions.PageInfo = more.PageInfo
+			}
+			zoneAfter = page.Edges[i].Cursor
+		}
+		g.LocationGroupZones.Edges = append(g.LocationGroupZones.Edges, page.Edges...)
+		if !page.PageInfo.HasNextPage {
+			return nil
+		}
+		after = page.PageInfo.EndCursor
+	}
+}
+
+// zoneMethodDefinitions returns the next page of a zone's rates. Zones can't be
+// queried directly, so the zone is addressed as the one after zoneAfter (the
+// previous zone's cursor, or "" for the first zone) in its location group.
+func (c *Client) zoneMethodDefinitions(profileID, groupID, zoneAfter, zoneID, after string) (*DeliveryMethodDefinitionConnection, error) {
+	gql := `
+		query DeliveryZoneMethodDefinitions($id: ID!, $groupId: ID!, $zoneAfter: String, $after: String) {
+			deliveryProfile(id: $id) {
+				profileLocationGroups(locationGroupId: $groupId) {
+					locationGroupZones(first: 1, after: $zoneAfter) {
+						edges {
+							node {
+								zone { id }
+								methodDefinitions(first: 25, after: $after) {
+									edges { node {` + deliveryMethodDefinitionFields + `} }
+									pageInfo { hasNextPage endCursor }
+								}
+							}
+						}
+					}
+				}
+			}
+		}`
+	vars := map[string]any{"id": profileID, "groupId": groupID, "after": after}
+	if zoneAfter != "" {
+		vars["zoneAfter"] = zoneAfter
+	}
+	resp, err := c.Do(gql, vars)
+	if err != nil {
+		return nil, err
+	}
+	var data struct {
+		DeliveryProfile *struct {
+			ProfileLocationGroups []struct {
+				LocationGroupZones DeliveryLocationGroupZoneConnection `json:"locationGroupZones"`
+			} `json:"profileLocationGroups"`
+		} `json:"deliveryProfile"`
+	}
+	if err := json.Unmarshal(resp.Data, &data); err != nil {
+		return nil, fmt.Errorf("parsing delivery rates: %w", err)
+	}
+	if data.DeliveryProfile == nil || len(data.DeliveryProfile.ProfileLocationGroups) != 1 ||
+		len(data.DeliveryProfile.ProfileLocationGroups[0].LocationGroupZones.Edges) != 1 {
+		return nil, fmt.Errorf("delivery zone %s not found", zoneID)
+	}
+	z := data.DeliveryProfile.ProfileLocationGroups[0].LocationGroupZones.Edges[0].Node
+	if z.Zone.ID != zoneID {
+		return nil, fmt.Errorf("delivery zones changed while reading profile %s — try again", profileID)
+	}
+	return &z.MethodDefinitions, nil
+}
+
+// UpdateDeliveryProfile applies a DeliveryProfileInput (profileLocationGroups,
+// zonesToDelete, methodDefinitionsToDelete, conditionsToDelete, ...) to a profile.
+func (c *Client) UpdateDeliveryProfile(id string, profile map[string]any) error {
+	const gql = `
+		mutation deliveryProfileUpdate($id: ID!, $profile: DeliveryProfileInput!) {
+			deliveryProfileUpdate(id: $id, profile: $profile) {
+				profile { id }
+				userErrors { field message }
+			}
+		}`
+	resp, err := c.Do(gql, map[string]any{"id": ToGID("DeliveryProfile", id), "profile": profile})
+	if err != nil {
+		return err
+	}
+	var data struct {
+		DeliveryProfileUpdate struct {
+			UserErrors []UserError `json:"userErrors"`
+		} `json:"deliveryProfileUpdate"`
+	}
+	if err := json.Unmarshal(resp.Data, &data); err != nil {
+		return fmt.Errorf("parsing response: %w", err)
+	}
+	return userErrorsToError(data.DeliveryProfileUpdate.UserErrors)
+}
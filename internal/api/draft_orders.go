@@ -0,0 +1,251 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+const draftOrderFields = `
+	id name status email note2 tags invoiceUrl invoiceSentAt
+	createdAt updatedAt completedAt
+	subtotalPriceSet { shopMoney { amount currencyCode } }
+	totalTaxSet { shopMoney { amount currencyCode } }
+	totalShippingPriceSet { shopMoney { amount currencyCode } }
+	totalPriceSet { shopMoney { amount currencyCode } }
+	appliedDiscount {
+		title value valueType
+		amountSet { shopMoney { amount currencyCode } }
+	}
+	customer { id firstName lastName email }
+	shippingAddress {
+		firstName lastName address1 address2
+		city province zip country phone
+	}
+	order { id name }
+	lineItems(first: 100) {
+		edges {
+			node {
+				id title sku quantity custom
+				originalUnitPriceSet { shopMoney { amount currencyCode } }
+				discountedTotalSet { shopMoney { amount currencyCode } }
+			}
+		}
+		pageInfo { hasNextPage endCursor }
+	}`
+
+// ListDraftOrders returns a paginated list of draft orders.
+func (c *Client) ListDraftOrders(first int, after, query string) (*DraftOrderConnection, error) {
+	const gql = `
+		query ListDraftOrders($first: Int!, $after: String, $query: String) {
+			draftOrders(first: $first, after: $after, query: $query, reverse: true) {
+				edges {
+					cursor
+					node {
+						id name status email tags createdAt updatedAt
+						totalPriceSet { shopMoney { amount currencyCode } }
+						customer { id firstName lastName email }
+						order { id name }
+					}
+				}
+				pageInfo { hasNextPage endCursor }
+			}
+		}`
+	vars := map[string]any{"first": first}
+	if after != "" {
+		vars["after"] = after
+	}
+	if query != "" {
+		vars["query"] = query
+	}
+	resp, err := c.Do(gql, vars)
+	if err != nil {
+		return nil, err
+	}
+	var data struct {
+		DraftOrders DraftOrderConnection `json:"draftOrders"`
+	}
+	if err := json.Unmarshal(resp.Data, &data); err != nil {
+		return nil, fmt.Errorf("parsing draft orders: %w", err)
+	}
+	return &data.DraftOrders, nil
+}
+
+// GetDraftOrder returns a single draft order by ID with its line items.
+func (c *Client) GetDraftOrder(id string) (*DraftOrder, error) {
+	gql := `
+		query GetDraftOrder($id: ID!) {
+			draftOrder(id: $id) {` + draftOrderFields + `}
+		}`
+	resp, err := c.Do(gql, map[string]any{"id": ToGID("DraftOrder", id)})
+	if err != nil {
+		return nil, err
+	}
+	var data struct {
+		DraftOrder *DraftOrder `json:"draftOrder"`
+	}
+	if err := json.Unmarshal(resp.Data, &data); err != nil {
+		return nil, fmt.Errorf("parsing draft order: %w", err)
+	}
+	if data.DraftOrder == nil {
+		return nil, fmt.Errorf("draft order %s not found", id)
+	}
+	return data.DraftOrder, nil
+}
+
+// CreateDraftOrder creates a draft order from a DraftOrderInput.
+func (c *Client) CreateDraftOrder(input map[string]any) (*DraftOrder, error) {
+	gql := `
+		mutation draftOrderCreate($input: DraftOrderInput!) {
+			draftOrderCreate(input: $input) {
+				draftOrder {` + draftOrderFields + `}
+				userErrors { field message }
+			}
+		}`
+	resp, err := c.Do(gql, map[string]any{"input": input})
+	if err != nil {
+		return nil, err
+	}
+	var data struct {
+		DraftOrderCreate struct {
+			DraftOrder *DraftOrder `json:"draftOrder"`
+			UserErrors []UserError `json:"userErrors"`
+		} `json:"draftOrderCreate"`
+	}
+	if err := json.Unmarshal(resp.Data, &data); err != nil {
+		return nil, fmt.Errorf("parsing response: %w", err)
+	}
+	if err := userErrorsToError(data.DraftOrderCreate.UserErrors); err != nil {
+		return nil, err
+	}
+	return data.DraftOrderCreate.DraftOrder, nil
+}
+
+// UpdateDraftOrder updates a draft order from a DraftOrderInput.
+// Passing lineItems replaces all existing line items.
+func (c *Client) UpdateDraftOrder(id string, input map[string]any) (*DraftOrder, error) {
+	gql := `
+		mutation draftOrderUpdate($id: ID!, $input: DraftOrderInput!) {
+			draftOrderUpdate(id: $id, input: $input) {
+				draftOrder {` + draftOrderFields + `}
+				userErrors { field message }
+			}
+		}`
+	resp, err := c.Do(gql, map[string]any{"id": ToGID("DraftOrder", id), "input": input})
+	if err != nil {
+		return nil, err
+	}
+	var data struct {
+		DraftOrderUpdate struct {
+			DraftOrder *DraftOrder `json:"draftOrder"`
+			UserErrors []UserError `json:"userErrors"`
+		} `json:"draftOrderUpdate"`
+	}
+	if err := json.Unmarshal(resp.Data, &data); err != nil {
+		return nil, fmt.Errorf("parsing response: %w", err)
+	}
+	if err := userErrorsToError(data.DraftOrderUpdate.UserErrors); err != nil {
+		return nil, err
+	}
+	return data.DraftOrderUpdate.DraftOrder, nil
+}
+
+// DeleteDraftOrder deletes a draft order.
+func (c *Client) DeleteDraftOrder(id string) error {
+	const gql = `
+		mutation draftOrderDelete($input: DraftOrderDeleteInput!) {
+			draftOrderDelete(input: $input) {
+				deletedId
+				userErrors { field message }
+			}
+		}`
+	resp, err := c.Do(gql, map[string]any{"input": map[string]any{"id": ToGID("DraftOrder", id)}})
+	if err != nil {
+		return err
+	}
+	var data struct {
+		DraftOrderDelete struct {
+			DeletedID  string      `json:"deletedId"`
+			UserErrors []UserError `json:"userErrors"`
+		} `json:"draftOrderDelete"`
+	}
+	if err := json.Unmarshal(resp.Data, &data); err != nil {
+		return fmt.Errorf("parsing response: %w", err)
+	}
+	return userErrorsToError(data.DraftOrderDelete.UserErrors)
+}
+
+// CompleteDraftOrder converts a draft order into an order. With paymentPending the
+// order is created unpaid (e.g. for net terms); otherwise it is marked as paid.
+func (c *Client) CompleteDraftOrder(id string, paymentPending bool) (*DraftOrder, error) {
+	const gql = `
+		mutation draftOrderComplete($id: ID!, $paymentPending: Boolean) {
+			draftOrderComplete(id: $id, paymentPending: $paymentPending) {
+				draftOrder {
+					id name status completedAt
+					totalPriceSet { shopMoney { amount currencyCode } }
+					order { id name }
+				}
+				userErrors { field message }
+			}
+		}`
+	resp, err := c.Do(gql, map[string]any{"id": ToGID("DraftOrder", id), "paymentPending": paymentPending})
+	if err != nil {
+		return nil, err
+	}
+	var data struct {
+		DraftOrderComplete struct {
+			DraftOrder *DraftOrder `json:"draftOrder"`
+			UserErrors []UserError `json:"userErrors"`
+		} `json:"draftOrderComplete"`
+	}
+	if err := json.Unmarshal(resp.Data, &data); err != nil {
+		return nil, fmt.Errorf("parsing response: %w", err)
+	}
+	if err := userErrorsToError(data.DraftOrderComplete.UserErrors); err != nil {
+		return nil, err
+	}
+	return data.DraftOrderComplete.DraftOrder, nil
+}
+
+// SendDraftOrderInvoice emails the draft order invoice. Empty values use the
+// customer's email and the shop's default subject and message.
+func (c *Client) SendDraftOrderInvoice(id, to, subject, message string) (*DraftOrder, error) {
+	const gql = `
+		mutation draftOrderInvoiceSend($id: ID!, $email: EmailInput) {
+			draftOrderInvoiceSend(id: $id, email: $email) {
+				draftOrder { id name email invoiceUrl invoiceSentAt }
+				userErrors { field message }
+			}
+		}`
+	vars := map[string]any{"id": ToGID("DraftOrder", id)}
+	email := map[string]any{}
+	if to != "" {
+		email["to"] = to
+	}
+	if subject != "" {
+		email["subject"] = subject
+	}
+	if message != "" {
+		email["customMessage"] = message
+	}
+	if len(email) > 0 {
+		vars["email"] = email
+	}
+	resp, err := c.Do(gql, vars)
+	if err != nil {
+		return nil, err
+	}
+	var data struct {
+		DraftOrderInvoiceSend struct {
+			DraftOrder *DraftOrder `json:"draftOrder"`
+			UserErrors []UserError `json:"userErrors"`
+		} `json:"draftOrderInvoiceSend"`
+	}
+	if err := json.Unmarshal(resp.Data, &data); err != nil {
+		return nil, fmt.Errorf("parsing response: %w", err)
+	}
+	if err := userErrorsToError(data.DraftOrderInvoiceSend.UserErrors); err != nil {
+		return nil, err
+	}
+	return data.DraftOrderInvoiceSend.DraftOrder, nil
+}
@@ -0,0 +1,157 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+const fulfillmentServiceFields = `
+	id serviceName handle type callbackUrl
+	inventoryManagement trackingSupport permitsSkuSharing requiresShippingMethod
+	location { id name }`
+
+// ListFulfillmentServices returns the shop's fulfillment services.
+func (c *Client) ListFulfillmentServices() ([]FulfillmentService, error) {
+	gql := `
+		query ListFulfillmentServices {
+			shop {
+				fulfillmentServices {` + fulfillmentServiceFields + `}
+			}
+		}`
+	resp, err := c.Do(gql, nil)
+	if err != nil {
+		return nil, err
+	}
+	var data struct {
+		Shop struct {
+			FulfillmentServices []FulfillmentService `json:"fulfillmentServices"`
+		} `json:"shop"`
+	}
+	if err := json.Unmarshal(resp.Data, &data); err != nil {
+		return nil, fmt.Errorf("parsing fulfillment services: %w", err)
+	}
+	return data.Shop.FulfillmentServices, nil
+}
+
+// GetFulfillmentService returns a single fulfillment service.
+func (c *Client) GetFulfillmentService(id string) (*FulfillmentService, error) {
+	gql := `
+		query GetFulfillmentService($id: ID!) {
+			fulfillmentService(id: $id) {` + fulfillmentServiceFields + `}
+		}`
+	resp, err := c.Do(gql, map[string]any{"id": ToGID("FulfillmentService", id)})
+	if err != nil {
+		return nil, err
+	}
+	var data struct {
+		FulfillmentService *FulfillmentService `json:"fulfillmentService"`
+	}
+	if err := json.Unmarshal(resp.Data, &data); err != nil {
+		return nil, fmt.Errorf("parsing fulfillment service: %w", err)
+	}
+	if data.FulfillmentService == nil {
+		return nil, fmt.Errorf("fulfillment service %s not found", id)
+	}
+	return data.FulfillmentService, nil
+}
+
+// CreateFulfillmentService registers a fulfillment service. fields holds the
+// optional callbackUrl, inventoryManagement, trackingSupport, permitsSkuSharing,
+// and requiresShippingMethod arguments; omitted ones take Shopify's defaults.
+func (c *Client) CreateFulfillmentService(name string, fields map[string]any) (*FulfillmentService, error) {
+	gql := `
+		mutation fulfillmentServiceCreate($name: String!, $callbackUrl: URL, $inventoryManagement: Boolean, $trackingSupport: Boolean, $permitsSkuSharing: Boolean, $requiresShippingMethod: Boolean) {
+			fulfillmentServiceCreate(name: $name, callbackUrl: $callbackUrl, inventoryManagement: $inventoryManagement, trackingSupport: $trackingSupport, permitsSkuSharing: $permitsSkuSharing, requiresShippingMethod: $requiresShippingMethod) {
+				fulfillmentService {` + fulfillmentServiceFields + `}
+				userErrors { field message }
+			}
+		}`
+	vars := map[string]any{"name": name}
+	for k, v := range fields {
+		vars[k] = v
+	}
+	resp, err := c.Do(gql, vars)
+	if err != nil {
+		return nil, err
+	}
+	var data struct {
+		FulfillmentServiceCreate struct {
+			FulfillmentService *FulfillmentService `json:"fulfillmentService"`
+			UserErrors         []UserError         `json:"userErrors"`
+		} `json:"fulfillmentServiceCreate"`
+	}
+	if err := json.Unmarshal(resp.Data, &data); err != nil {
+		return nil, fmt.Errorf("parsing response: %w", err)
+	}
+	if err := userErrorsToError(data.FulfillmentServiceCreate.UserErrors); err != nil {
+		return nil, err
+	}
+	return data.FulfillmentServiceCreate.FulfillmentService, nil
+}
+
+// UpdateFulfillmentService changes the given fields (name, callbackUrl,
+// inventoryManagement, trackingSupport, permitsSkuSharing, requiresShippingMethod)
+// and leaves the rest as they are.
+func (c *Client) UpdateFulfillmentService(id string, fields map[string]any) (*FulfillmentService, error) {
+	gql := `
+		mutation fulfillmentServiceUpdate($id: ID!, $name: String, $callbackUrl: URL, $inventoryManagement: Boolean, $trackingSupport: Boolean, $permitsSkuSharing: Boolean, $requiresShippingMethod: Boolean) {
+			fulfillmentServiceUpdate(id: $id, name: $name, callbackUrl: $callbackUrl, inventoryManagement: $inventoryManagement, trackingSupport: $trackingSupport, permitsSkuSharing: $permitsSkuSharing, requiresShippingMethod: $requiresShippingMethod) {
+				fulfillmentService {` + fulfillmentServiceFields + `}
+				userErrors { field message }
+			}
+		}`
+	vars := map[string]any{"id": ToGID("FulfillmentService", id)}
+	for k, v := range fields {
+		vars[k] = v
+	}
+	resp, err := c.Do(gql, vars)
+	if err != nil {
+		return nil, err
+	}
+	var data struct {
+		FulfillmentServiceUpdate struct {
+			FulfillmentService *FulfillmentService `json:"fulfillmentService"`
+			UserErrors         []UserError         `json:"userErrors"`
+		} `json:"fulfillmentServiceUpdate"`
+	}
+	if err := json.Unmarshal(resp.Data, &data); err != nil {
+		return nil, fmt.Errorf("parsing response: %w", err)
+	}
+	if err := userErrorsToError(data.FulfillmentServiceUpdate.UserErrors); err != nil {
+		return nil, err
+	}
+	return data.FulfillmentServiceUpdate.FulfillmentService, nil
+}
+
+// DeleteFulfillmentService removes a fulfillment service and its location.
+// inventoryAction is TRANSFER (to destinationLocationID), KEEP (the location stays
+// as a merchant-managed location), or DELETE; empty uses Shopify's default.
+func (c *Client) DeleteFulfillmentService(id, inventoryAction, destinationLocationID string) error {
+	const gql = `
+		mutation fulfillmentServiceDelete($id: ID!, $inventoryAction: FulfillmentServiceDeleteInventoryAction, $destinationLocationId: ID) {
+			fulfillmentServiceDelete(id: $id, inventoryAction: $inventoryAction, destinationLocationId: $destinationLocationId) {
+				deletedId
+				userErrors { field message }
+			}
+		}`
+	vars := map[string]any{"id": ToGID("FulfillmentService", id)}
+	if inventoryAction != "" {
+		vars["inventoryAction"] = inventoryAction
+	}
+	if destinationLocationID != "" {
+		vars["destinationLocationId"] = ToGID("Location", destinationLocationID)
+	}
+	resp, err := c.Do(gql, vars)
+	if err != nil {
+		return err
+	}
+	var data struct {
+		FulfillmentServiceDelete struct {
+			UserErrors []UserError `json:"userErrors"`
+		} `json:"fulfillmentServiceDelete"`
+	}
+	if err := json.Unmarshal(resp.Data, &data); err != nil {
+		return fmt.Errorf("parsing response: %w", err)
+	}
+	return userErrorsToError(data.FulfillmentServiceDelete.UserErrors)
+}
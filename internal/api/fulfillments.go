@@ -15,7 +15,9 @@ func (c *Client) ListFulfillmentOrders(orderID string) (*FulfillmentOrderConnect
 						cursor
 						node {
 							id status requestStatus fulfillAt
-							assignedLocation { name }
+							order { id name }
+							assignedLocation { name location { id name } }
+							fulfillmentHolds { id reason reasonNotes }
 							destination {
 								firstName lastName address1 city country zip
 							}
@@ -48,8 +50,17 @@ func (c *Client) ListFulfillmentOrders(orderID string) (*FulfillmentOrderConnect
 	return &data.Order.FulfillmentOrders, nil
 }
 
-// CreateFulfillment creates a fulfillment for a fulfillment order.
-func (c *Client) CreateFulfillment(fulfillmentOrderID, trackingCompany, trackingNumber, trackingURL string) (*Fulfillment, error) {
+// FulfillmentOrderLines selects line item quantities from one fulfillment order.
+// With no Lines, every remaining line item is included.
+type FulfillmentOrderLines struct {
+	FulfillmentOrderID string
+	Lines              []FulfillmentOrderLineInput
+}
+
+// CreateFulfillment creates one fulfillment, with a single tracking number, for
+// line items of one or more fulfillment orders. The fulfillment orders must be
+// assigned to the same location.
+func (c *Client) CreateFulfillment(orders []FulfillmentOrderLines, trackingCompany, trackingNumber, trackingURL string, notifyCustomer bool) (*Fulfillment, error) {
 	const gql = `
 		mutation fulfillmentCreateV2($fulfillment: FulfillmentV2Input!) {
 			fulfillmentCreateV2(fulfillment: $fulfillment) {
@@ -60,11 +71,17 @@ func (c *Client) CreateFulfillment(fulfillmentOrderID, trackingCompany, tracking
 				userErrors { field message }
 			}
 		}`
-	lineItemsByFulfillmentOrder := []map[string]any{
-		{"fulfillmentOrderId": ToGID("FulfillmentOrder", fulfillmentOrderID)},
+	lineItemsByFulfillmentOrder := make([]map[string]any, len(orders))
+	for i, fo := range orders {
+		byFulfillmentOrder := map[string]any{"fulfillmentOrderId": ToGID("FulfillmentOrder", fo.FulfillmentOrderID)}
+		if len(fo.Lines) > 0 {
+			byFulfillmentOrder["fulfillmentOrderLineItems"] = fulfillmentOrderLinesVar(fo.Lines)
+		}
+		lineItemsByFulfillmentOrder[i] = byFulfillmentOrder
 	}
 	fulfillment := map[string]any{
 		"lineItemsByFulfillmentOrder": lineItemsByFulfillmentOrder,
+		"notifyCustomer":              notifyCustomer,
 	}
 	if trackingCompany != "" || trackingNumber != "" {
 		trackingInfo := map[string]any{}
@@ -83,42 +100,389 @@ func (c *Client) CreateFulfillment(fulfillmentOrderID, trackingCompany, tracking
 	if err != nil {
 		return nil, err
 	}
-	var rawData struct {
+	var data struct {
 		FulfillmentCreateV2 struct {
-			Fulfillment *struct {
-				ID          string `json:"id"`
-				Status      string `json:"status"`
-				CreatedAt   string `json:"createdAt"`
-				TrackingInfo []struct {
-					Company string `json:"company"`
-					Number  string `json:"number"`
-					URL     string `json:"url"`
-				} `json:"trackingInfo"`
-			} `json:"fulfillment"`
-			UserErrors []UserError `json:"userErrors"`
+			Fulfillment *fulfillmentNode `json:"fulfillment"`
+			UserErrors  []UserError      `json:"userErrors"`
 		} `json:"fulfillmentCreateV2"`
 	}
-	if err := json.Unmarshal(resp.Data, &rawData); err != nil {
+	if err := json.Unmarshal(resp.Data, &data); err != nil {
+		return nil, fmt.Errorf("parsing response: %w", err)
+	}
+	if err := userErrorsToError(data.FulfillmentCreateV2.UserErrors); err != nil {
+		return nil, err
+	}
+	if data.FulfillmentCreateV2.Fulfillment == nil {
+		return nil, fmt.Errorf("no fulfillment returned")
+	}
+	return data.FulfillmentCreateV2.Fulfillment.toFulfillment(), nil
+}
+
+// fulfillmentNode is a fulfillment as the API returns it, with tracking info as a
+// list of {company number url} objects.
+type fulfillmentNode struct {
+	Fulfillment
+	TrackingInfo []TrackingInfo `json:"trackingInfo"`
+}
+
+// toFulfillment flattens the tracking info into Fulfillment's tracking fields.
+func (n *fulfillmentNode) toFulfillment() *Fulfillment {
+	f := n.Fulfillment
+	if len(n.TrackingInfo) > 0 {
+		f.TrackingCompany = n.TrackingInfo[0].Company
+		for _, t := range n.TrackingInfo {
+			f.TrackingNumbers = append(f.TrackingNumbers, t.Number)
+			f.TrackingURLs = append(f.TrackingURLs, t.URL)
+		}
+	}
+	return &f
+}
+
+const fulfillmentFields = `
+	id name status displayStatus createdAt updatedAt estimatedDeliveryAt deliveredAt
+	trackingInfo { company number url }
+	order { id name }
+	location { id name }
+	fulfillmentLineItems(first: 50) {
+		edges { node { id quantity lineItem { id title sku } } }
+	}
+	events(first: 50, sortKey: HAPPENED_AT) {
+		edges {
+			node { id status happenedAt message city province country estimatedDeliveryAt }
+		}
+	}`
+
+// GetFulfillment returns a fulfillment with its tracking info, line items, and
+// carrier events.
+func (c *Client) GetFulfillment(id string) (*Fulfillment, error) {
+	gql := `
+		query GetFulfillment($id: ID!) {
+			fulfillment(id: $id) {` + fulfillmentFields + `}
+		}`
+	resp, err := c.Do(gql, map[string]any{"id": ToGID("Fulfillment", id)})
+	if err != nil {
+		return nil, err
+	}
+	var data struct {
+		Fulfillment *fulfillmentNode `json:"fulfillment"`
+	}
+	if err := json.Unmarshal(resp.Data, &data); err != nil {
+		return nil, fmt.Errorf("parsing fulfillment: %w", err)
+	}
+	if data.Fulfillment == nil {
+		return nil, fmt.Errorf("fulfillment %s not found", id)
+	}
+	return data.Fulfillment.toFulfillment(), nil
+}
+
+// UpdateFulfillmentTracking replaces a fulfillment's tracking company, number,
+// and URL, optionally emailing the customer the new tracking details.
+func (c *Client) UpdateFulfillmentTracking(id, company, number, url string, notifyCustomer bool) (*Fulfillment, error) {
+	gql := `
+		mutation fulfillmentTrackingInfoUpdate($fulfillmentId: ID!, $trackingInfoInput: FulfillmentTrackingInput!, $notifyCustomer: Boolean) {
+			fulfillmentTrackingInfoUpdate(fulfillmentId: $fulfillmentId, trackingInfoInput: $trackingInfoInput, notifyCustomer: $notifyCustomer) {
+				fulfillment {` + fulfillmentFields + `}
+				userErrors { field message }
+			}
+		}`
+	tracking := map[string]any{}
+	if company != "" {
+		tracking["company"] = company
+	}
+	if number != "" {
+		tracking["number"] = number
+	}
+	if url != "" {
+		tracking["url"] = url
+	}
+	resp, err := c.Do(gql, map[string]any{
+		"fulfillmentId":     ToGID("Fulfillment", id),
+		"trackingInfoInput": tracking,
+		"notifyCustomer":    notifyCustomer,
+	})
+	if err != nil {
+		return nil, err
+	}
+	var data struct {
+		FulfillmentTrackingInfoUpdate struct {
+			Fulfillment *fulfillmentNode `json:"fulfillment"`
+			UserErrors  []UserError      `json:"userErrors"`
+		} `json:"fulfillmentTrackingInfoUpdate"`
+	}
+	if err := json.Unmarshal(resp.Data, &data); err != nil {
+		return nil, fmt.Errorf("parsing response: %w", err)
+	}
+	if err := userErrorsToError(data.FulfillmentTrackingInfoUpdate.UserErrors); err != nil {
+		return nil, err
+	}
+	if data.FulfillmentTrackingInfoUpdate.Fulfillment == nil {
+		return nil, fmt.Errorf("no fulfillment returned")
+	}
+	return data.FulfillmentTrackingInfoUpdate.Fulfillment.toFulfillment(), nil
+}
+
+// CancelFulfillment cancels a fulfillment. Its line items return to their
+// fulfillment order so they can be fulfilled again.
+func (c *Client) CancelFulfillment(id string) (*Fulfillment, error) {
+	const gql = `
+		mutation fulfillmentCancel($id: ID!) {
+			fulfillmentCancel(id: $id) {
+				fulfillment { id name status displayStatus createdAt updatedAt order { id name } }
+				userErrors { field message }
+			}
+		}`
+	resp, err := c.Do(gql, map[string]any{"id": ToGID("Fulfillment", id)})
+	if err != nil {
+		return nil, err
+	}
+	var data struct {
+		FulfillmentCancel struct {
+			Fulfillment *fulfillmentNode `json:"fulfillment"`
+			UserErrors  []UserError      `json:"userErrors"`
+		} `json:"fulfillmentCancel"`
+	}
+	if err := json.Unmarshal(resp.Data, &data); err != nil {
 		return nil, fmt.Errorf("parsing response: %w", err)
 	}
-	if err := userErrorsToError(rawData.FulfillmentCreateV2.UserErrors); err != nil {
+	if err := userErrorsToError(data.FulfillmentCancel.UserErrors); err != nil {
 		return nil, err
 	}
-	if rawData.FulfillmentCreateV2.Fulfillment == nil {
+	if data.FulfillmentCancel.Fulfillment == nil {
 		return nil, fmt.Errorf("no fulfillment returned")
 	}
-	f := rawData.FulfillmentCreateV2.Fulfillment
-	result := &Fulfillment{
-		ID:        f.ID,
-		Status:    f.Status,
-		CreatedAt: f.CreatedAt,
-	}
-	if len(f.TrackingInfo) > 0 {
-		result.TrackingCompany = f.TrackingInfo[0].Company
-		for _, t := range f.TrackingInfo {
-			result.TrackingNumbers = append(result.TrackingNumbers, t.Number)
-			result.TrackingURLs = append(result.TrackingURLs, t.URL)
+	return data.FulfillmentCancel.Fulfillment.toFulfillment(), nil
+}
+
+// FulfillmentOrderLineInput selects a quantity of a fulfillment order line item,
+// for partial fulfillments, holds, and moves.
+type FulfillmentOrderLineInput struct {
+	ID       string
+	Quantity int
+}
+
+func fulfillmentOrderLinesVar(lines []FulfillmentOrderLineInput) []map[string]any {
+	out := make([]map[string]any, len(lines))
+	for i, l := range lines {
+		out[i] = map[string]any{"id": ToGID("FulfillmentOrderLineItem", l.ID), "quantity": l.Quantity}
+	}
+	return out
+}
+
+const fulfillmentOrderFields = `
+	id status requestStatus fulfillAt
+	assignedLocation { name location { id name } }
+	fulfillmentHolds { id reason reasonNotes }
+	order { id name }
+	lineItems(first: 50) {
+		edges {
+			node {
+				id remainingQuantity totalQuantity
+				lineItem { id title sku }
+			}
 		}
+	}`
+
+// GetFulfillmentOrder returns a single fulfillment order with its holds and line items.
+func (c *Client) GetFulfillmentOrder(id string) (*FulfillmentOrder, error) {
+	gql := `
+		query GetFulfillmentOrder($id: ID!) {
+			fulfillmentOrder(id: $id) {` + fulfillmentOrderFields + `}
+		}`
+	resp, err := c.Do(gql, map[string]any{"id": ToGID("FulfillmentOrder", id)})
+	if err != nil {
+		return nil, err
+	}
+	var data struct {
+		FulfillmentOrder *FulfillmentOrder `json:"fulfillmentOrder"`
+	}
+	if err := json.Unmarshal(resp.Data, &data); err != nil {
+		return nil, fmt.Errorf("parsing fulfillment order: %w", err)
+	}
+	if data.FulfillmentOrder == nil {
+		return nil, fmt.Errorf("fulfillment order %s not found", id)
+	}
+	return data.FulfillmentOrder, nil
+}
+
+// HoldFulfillmentOrder puts a fulfillment order (or some of its line items) on hold.
+// When only some line items are held, the fulfillment order keeps them and goes on
+// hold, and Shopify moves the rest to a new fulfillment order that can still be
+// fulfilled; both are returned.
+func (c *Client) HoldFulfillmentOrder(id, reason, notes string, lines []FulfillmentOrderLineInput) (*FulfillmentOrderHoldResult, error) {
+	gql := `
+		mutation fulfillmentOrderHold($id: ID!, $fulfillmentHold: FulfillmentOrderHoldInput!) {
+			fulfillmentOrderHold(id: $id, fulfillmentHold: $fulfillmentHold) {
+				fulfillmentOrder {` + fulfillmentOrderFields + `}
+				remainingFulfillmentOrder {` + fulfillmentOrderFields + `}
+				userErrors { field message }
+			}
+		}`
+	hold := map[string]any{"reason": reason}
+	if notes != "" {
+		hold["reasonNotes"] = notes
+	}
+	if len(lines) > 0 {
+		hold["fulfillmentOrderLineItems"] = fulfillmentOrderLinesVar(lines)
+	}
+	resp, err := c.Do(gql, map[string]any{"id": ToGID("FulfillmentOrder", id), "fulfillmentHold": hold})
+	if err != nil {
+		return nil, err
+	}
+	var data struct {
+		FulfillmentOrderHold struct {
+			FulfillmentOrderHoldResult
+			UserErrors []UserError `json:"userErrors"`
+		} `json:"fulfillmentOrderHold"`
+	}
+	if err := json.Unmarshal(resp.Data, &data); err != nil {
+		return nil, fmt.Errorf("parsing response: %w", err)
+	}
+	if err := userErrorsToError(data.FulfillmentOrderHold.UserErrors); err != nil {
+		return nil, err
+	}
+	return &data.FulfillmentOrderHold.FulfillmentOrderHoldResult, nil
+}
+
+// ReleaseFulfillmentOrderHold releases holds on a fulfillment order. With no
+// holdIDs, all holds are released.
+func (c *Client) ReleaseFulfillmentOrderHold(id string, holdIDs []string) (*FulfillmentOrder, error) {
+	gql := `
+		mutation fulfillmentOrderReleaseHold($id: ID!, $holdIds: [ID!]) {
+			fulfillmentOrderReleaseHold(id: $id, holdIds: $holdIds) {
+				fulfillmentOrder {` + fulfillmentOrderFields + `}
+				userErrors { field message }
+			}
+		}`
+	vars := map[string]any{"id": ToGID("FulfillmentOrder", id)}
+	if len(holdIDs) > 0 {
+		ids := make([]string, len(holdIDs))
+		for i, h := range holdIDs {
+			ids[i] = ToGID("FulfillmentHold", h)
+		}
+		vars["holdIds"] = ids
+	}
+	resp, err := c.Do(gql, vars)
+	if err != nil {
+		return nil, err
+	}
+	var data struct {
+		FulfillmentOrderReleaseHold struct {
+			FulfillmentOrder *FulfillmentOrder `json:"fulfillmentOrder"`
+			UserErrors       []UserError       `json:"userErrors"`
+		} `json:"fulfillmentOrderReleaseHold"`
+	}
+	if err := json.Unmarshal(resp.Data, &data); err != nil {
+		return nil, fmt.Errorf("parsing response: %w", err)
+	}
+	if err := userErrorsToError(data.FulfillmentOrderReleaseHold.UserErrors); err != nil {
+		return nil, err
+	}
+	return data.FulfillmentOrderReleaseHold.FulfillmentOrder, nil
+}
+
+// ListFulfillmentOrderMoveLocations returns the locations a fulfillment order could
+// be moved to, and whether each can take it.
+func (c *Client) ListFulfillmentOrderMoveLocations(id string) ([]FulfillmentOrderMoveLocation, error) {
+	const gql = `
+		query ListFulfillmentOrderMoveLocations($id: ID!) {
+			fulfillmentOrder(id: $id) {
+				locationsForMove(first: 50) {
+					edges {
+						node {
+							location { id name }
+							movable message
+						}
+					}
+				}
+			}
+		}`
+	resp, err := c.Do(gql, map[string]any{"id": ToGID("FulfillmentOrder", id)})
+	if err != nil {
+		return nil, err
+	}
+	var data struct {
+		FulfillmentOrder *struct {
+			LocationsForMove struct {
+				Edges []struct {
+					Node FulfillmentOrderMoveLocation `json:"node"`
+				} `json:"edges"`
+			} `json:"locationsForMove"`
+		} `json:"fulfillmentOrder"`
+	}
+	if err := json.Unmarshal(resp.Data, &data); err != nil {
+		return nil, fmt.Errorf("parsing locations: %w", err)
+	}
+	if data.FulfillmentOrder == nil {
+		return nil, fmt.Errorf("fulfillment order %s not found", id)
+	}
+	locations := make([]FulfillmentOrderMoveLocation, len(data.FulfillmentOrder.LocationsForMove.Edges))
+	for i, e := range data.FulfillmentOrder.LocationsForMove.Edges {
+		locations[i] = e.Node
+	}
+	return locations, nil
+}
+
+// MoveFulfillmentOrder moves a fulfillment order (or some of its line items) to
+// another location and returns the fulfillment order now assigned there.
+func (c *Client) MoveFulfillmentOrder(id, locationID string, lines []FulfillmentOrderLineInput) (*FulfillmentOrder, error) {
+	gql := `
+		mutation fulfillmentOrderMove($id: ID!, $newLocationId: ID!, $lines: [FulfillmentOrderLineItemInput!]) {
+			fulfillmentOrderMove(id: $id, newLocationId: $newLocationId, fulfillmentOrderLineItems: $lines) {
+				movedFulfillmentOrder {` + fulfillmentOrderFields + `}
+				userErrors { field message }
+			}
+		}`
+	vars := map[string]any{
+		"id":            ToGID("FulfillmentOrder", id),
+		"newLocationId": ToGID("Location", locationID),
+	}
+	if len(lines) > 0 {
+		vars["lines"] = fulfillmentOrderLinesVar(lines)
+	}
+	resp, err := c.Do(gql, vars)
+	if err != nil {
+		return nil, err
+	}
+	var data struct {
+		FulfillmentOrderMove struct {
+			MovedFulfillmentOrder *FulfillmentOrder `json:"movedFulfillmentOrder"`
+			UserErrors            []UserError       `json:"userErrors"`
+		} `json:"fulfillmentOrderMove"`
+	}
+	if err := json.Unmarshal(resp.Data, &data); err != nil {
+		return nil, fmt.Errorf("parsing response: %w", err)
+	}
+	if err := userErrorsToError(data.FulfillmentOrderMove.UserErrors); err != nil {
+		return nil, err
+	}
+	return data.FulfillmentOrderMove.MovedFulfillmentOrder, nil
+}
+
+// RescheduleFulfillmentOrder changes when a scheduled fulfillment order becomes
+// ready to fulfill. fulfillAt is an ISO-8601 timestamp.
+func (c *Client) RescheduleFulfillmentOrder(id, fulfillAt string) (*FulfillmentOrder, error) {
+	gql := `
+		mutation fulfillmentOrderReschedule($id: ID!, $fulfillAt: DateTime!) {
+			fulfillmentOrderReschedule(id: $id, fulfillAt: $fulfillAt) {
+				fulfillmentOrder {` + fulfillmentOrderFields + `}
+				userErrors { field message }
+			}
+		}`
+	resp, err := c.Do(gql, map[string]any{"id": ToGID("FulfillmentOrder", id), "fulfillAt": fulfillAt})
+	if err != nil {
+		return nil, err
+	}
+	var data struct {
+		FulfillmentOrderReschedule struct {
+			FulfillmentOrder *FulfillmentOrder `json:"fulfillmentOrder"`
+			UserErrors       []UserError       `json:"userErrors"`
+		} `json:"fulfillmentOrderReschedule"`
+	}
+	if err := json.Unmarshal(resp.Data, &data); err != nil {
+		return nil, fmt.Errorf("parsing response: %w", err)
+	}
+	if err := userErrorsToError(data.FulfillmentOrderReschedule.UserErrors); err != nil {
+		return nil, err
 	}
-	return result, nil
+	return data.FulfillmentOrderReschedule.FulfillmentOrder, nil
 }
@@ -0,0 +1,182 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+const giftCardFields = `
+	id lastCharacters maskedCode enabled
+	initialValue { amount currencyCode }
+	balance { amount currencyCode }
+	expiresOn deactivatedAt note createdAt updatedAt
+	customer { id firstName lastName email }
+	order { id name }`
+
+// ListGiftCards returns a paginated list of gift cards.
+func (c *Client) ListGiftCards(first int, after, query string) (*GiftCardConnection, error) {
+	gql := `
+		query ListGiftCards($first: Int!, $after: String, $query: String) {
+			giftCards(first: $first, after: $after, query: $query) {
+				edges {
+					cursor
+					node {` + giftCardFields + `}
+				}
+				pageInfo { hasNextPage endCursor }
+			}
+		}`
+	vars := map[string]any{"first": first}
+	if after != "" {
+		vars["after"] = after
+	}
+	if query != "" {
+		vars["query"] = query
+	}
+	resp, err := c.Do(gql, vars)
+	if err != nil {
+		return nil, err
+	}
+	var data struct {
+		GiftCards GiftCardConnection `json:"giftCards"`
+	}
+	if err := json.Unmarshal(resp.Data, &data); err != nil {
+		return nil, fmt.Errorf("parsing gift cards: %w", err)
+	}
+	return &data.GiftCards, nil
+}
+
+// GetGiftCard returns a single gift card by ID.
+func (c *Client) GetGiftCard(id string) (*GiftCard, error) {
+	gql := `
+		query GetGiftCard($id: ID!) {
+			giftCard(id: $id) {` + giftCardFields + `}
+		}`
+	resp, err := c.Do(gql, map[string]any{"id": ToGID("GiftCard", id)})
+	if err != nil {
+		return nil, err
+	}
+	var data struct {
+		GiftCard *GiftCard `json:"giftCard"`
+	}
+	if err := json.Unmarshal(resp.Data, &data); err != nil {
+		return nil, fmt.Errorf("parsing gift card: %w", err)
+	}
+	if data.GiftCard == nil {
+		return nil, fmt.Errorf("gift card %s not found", id)
+	}
+	return data.GiftCard, nil
+}
+
+// CreateGiftCard issues a new gift card and returns it with its full code.
+// The code is only ever returned here; afterwards only the last characters are readable.
+func (c *Client) CreateGiftCard(initialValue, code, expiresOn, customerID, note string) (*GiftCard, string, error) {
+	gql := `
+		mutation giftCardCreate($input: GiftCardCreateInput!) {
+			giftCardCreate(input: $input) {
+				giftCard {` + giftCardFields + `}
+				giftCardCode
+				userErrors { field message }
+			}
+		}`
+	input := map[string]any{"initialValue": initialValue}
+	if code != "" {
+		input["code"] = code
+	}
+	if expiresOn != "" {
+		input["expiresOn"] = expiresOn
+	}
+	if customerID != "" {
+		input["customerId"] = ToGID("Customer", customerID)
+	}
+	if note != "" {
+		input["note"] = note
+	}
+	resp, err := c.Do(gql, map[string]any{"input": input})
+	if err != nil {
+		return nil, "", err
+	}
+	var data struct {
+		GiftCardCreate struct {
+			GiftCard     *GiftCard   `json:"giftCard"`
+			GiftCardCode string      `json:"giftCardCode"`
+			UserErrors   []UserError `json:"userErrors"`
+		} `json:"giftCardCreate"`
+	}
+	if err := json.Unmarshal(resp.Data, &data); err != nil {
+		return nil, "", fmt.Errorf("parsing response: %w", err)
+	}
+	if err := userErrorsToError(data.GiftCardCreate.UserErrors); err != nil {
+		return nil, "", err
+	}
+	return data.GiftCardCreate.GiftCard, data.GiftCardCreate.GiftCardCode, nil
+}
+
+// UpdateGiftCard updates a gift card's expiry, customer, or note.
+// Empty values are left unchanged.
+func (c *Client) UpdateGiftCard(id, expiresOn, customerID, note string) (*GiftCard, error) {
+	gql := `
+		mutation giftCardUpdate($id: ID!, $input: GiftCardUpdateInput!) {
+			giftCardUpdate(id: $id, input: $input) {
+				giftCard {` + giftCardFields + `}
+				userErrors { field message }
+			}
+		}`
+	input := map[string]any{}
+	if expiresOn != "" {
+		input["expiresOn"] = expiresOn
+	}
+	if customerID != "" {
+		input["customerId"] = ToGID("Customer", customerID)
+	}
+	if note != "" {
+		input["note"] = note
+	}
+	resp, err := c.Do(gql, map[string]any{"id": ToGID("GiftCard", id), "input": input})
+	if err != nil {
+		return nil, err
+	}
+	var data struct {
+		GiftCardUpdate struct {
+			GiftCard   *GiftCard   `json:"giftCard"`
+			UserErrors []UserError `json:"userErrors"`
+		} `json:"giftCardUpdate"`
+	}
+	if err := json.Unmarshal(resp.Data, &data); err != nil {
+		return nil, fmt.Errorf("parsing response: %w", err)
+	}
+	if err := userErrorsToError(data.GiftCardUpdate.UserErrors); err != nil {
+		return nil, err
+	}
+	return data.GiftCardUpdate.GiftCard, nil
+}
+
+// DeactivateGiftCard permanently disables a gift card. This cannot be undone.
+func (c *Client) DeactivateGiftCard(id string) (*GiftCard, error) {
+	const gql = `
+		mutation giftCardDeactivate($id: ID!) {
+			giftCardDeactivate(id: $id) {
+				giftCard {
+					id lastCharacters enabled deactivatedAt
+					balance { amount currencyCode }
+				}
+				userErrors { field message }
+			}
+		}`
+	resp, err := c.Do(gql, map[string]any{"id": ToGID("GiftCard", id)})
+	if err != nil {
+		return nil, err
+	}
+	var data struct {
+		GiftCardDeactivate struct {
+			GiftCard   *GiftCard   `json:"giftCard"`
+			UserErrors []UserError `json:"userErrors"`
+		} `json:"giftCardDeactivate"`
+	}
+	if err := json.Unmarshal(resp.Data, &data); err != nil {
+		return nil, fmt.Errorf("parsing response: %w", err)
+	}
+	if err := userErrorsToError(data.GiftCardDeactivate.UserErrors); err != nil {
+		return nil, err
+	}
+	return data.GiftCardDeactivate.GiftCard, nil
+}
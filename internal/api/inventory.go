@@ -149,3 +149,74 @@ func (c *Client) AdjustInventory(inventoryItemID, locationID string, delta int,
 	}
 	return userErrorsToError(data.InventoryAdjustQuantities.UserErrors)
 }
+
+// SetInventoryQuantity sets the absolute quantity ("available" or "on_hand") of an
+// item at a location. When expect is non-nil the change only applies if the current
+// quantity still equals *expect; otherwise the current quantity is overwritten.
+func (c *Client) SetInventoryQuantity(inventoryItemID, locationID, name string, quantity int, expect *int, reason string) (*InventoryChange, error) {
+	const gql = `
+		mutation inventorySetQuantities($input: InventorySetQuantitiesInput!) {
+			inventorySetQuantities(input: $input) {
+				inventoryAdjustmentGroup {
+					changes { name delta quantityAfterChange }
+				}
+				userErrors { code field message }
+			}
+		}`
+	if reason == "" {
+		reason = "correction"
+	}
+	q := map[string]any{
+		"inventoryItemId": ToGID("InventoryItem", inventoryItemID),
+		"locationId":      ToGID("Location", locationID),
+		"quantity":        quantity,
+	}
+	input := map[string]any{
+		"reason":     reason,
+		"name":       name,
+		"quantities": []map[string]any{q},
+	}
+	if expect != nil {
+		q["compareQuantity"] = *expect
+	} else {
+		input["ignoreCompareQuantity"] = true
+	}
+	resp, err := c.Do(gql, map[string]any{"input": input})
+	if err != nil {
+		return nil, err
+	}
+	var data struct {
+		InventorySetQuantities struct {
+			InventoryAdjustmentGroup *struct {
+				Changes []InventoryChange `json:"changes"`
+			} `json:"inventoryAdjustmentGroup"`
+			UserErrors []struct {
+				Code string `json:"code"`
+				UserError
+			} `json:"userErrors"`
+		} `json:"inventorySetQuantities"`
+	}
+	if err := json.Unmarshal(resp.Data, &data); err != nil {
+		return nil, fmt.Errorf("parsing response: %w", err)
+	}
+	result := data.InventorySetQuantities
+	userErrors := make([]UserError, len(result.UserErrors))
+	for i, e := range result.UserErrors {
+		if e.Code == "COMPARE_QUANTITY_STALE" && expect != nil {
+			return nil, fmt.Errorf("%s quantity is no longer %d — it changed since it was read; nothing was set", name, *expect)
+		}
+		userErrors[i] = e.UserError
+	}
+	if err := userErrorsToError(userErrors); err != nil {
+		return nil, err
+	}
+	if result.InventoryAdjustmentGroup != nil {
+		for _, ch := range result.InventoryAdjustmentGroup.Changes {
+			if ch.Name == name {
+				return &ch, nil
+			}
+		}
+	}
+	// No adjustment group means the quantity was already at the target.
+	return &InventoryChange{Name: name, QuantityAfterChange: quantity}, nil
+}
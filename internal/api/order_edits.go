@@ -0,0 +1,168 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+const calculatedOrderFields = `
+	id
+	originalOrder {
+		id name presentmentCurrencyCode
+		totalPriceSet { shopMoney { amount currencyCode } }
+	}
+	subtotalPriceSet { shopMoney { amount currencyCode } }
+	cartDiscountAmountSet { shopMoney { amount currencyCode } }
+	totalPriceSet { shopMoney { amount currencyCode } }
+	totalOutstandingSet { shopMoney { amount currencyCode } }
+	lineItems(first: 100) {
+		edges {
+			node {
+				id title sku quantity editableQuantity
+				originalUnitPriceSet { shopMoney { amount currencyCode } }
+				discountedUnitPriceSet { shopMoney { amount currencyCode } }
+				stagedChanges { __typename }
+			}
+		}
+	}`
+
+// OrderEditDiscount is a line item discount: either a percentage or a fixed amount
+// in the order's presentment currency.
+type OrderEditDiscount struct {
+	Description  string
+	Percent      float64
+	FixedAmount  string
+	CurrencyCode string
+}
+
+// calculatedOrderPayload is the shape shared by every orderEdit* mutation response.
+type calculatedOrderPayload struct {
+	CalculatedOrder *CalculatedOrder `json:"calculatedOrder"`
+	UserErrors      []UserError      `json:"userErrors"`
+}
+
+// doOrderEdit runs an orderEdit* mutation and returns the updated calculated order.
+func (c *Client) doOrderEdit(gql, field string, vars map[string]any) (*CalculatedOrder, error) {
+	resp, err := c.Do(gql, vars)
+	if err != nil {
+		return nil, err
+	}
+	var data map[string]calculatedOrderPayload
+	if err := json.Unmarshal(resp.Data, &data); err != nil {
+		return nil, fmt.Errorf("parsing response: %w", err)
+	}
+	payload := data[field]
+	if err := userErrorsToError(payload.UserErrors); err != nil {
+		return nil, err
+	}
+	if payload.CalculatedOrder == nil {
+		return nil, fmt.Errorf("%s returned no calculated order", field)
+	}
+	return payload.CalculatedOrder, nil
+}
+
+// BeginOrderEdit starts an order edit session. Changes are staged on the returned
+// calculated order and only applied to the order by CommitOrderEdit.
+func (c *Client) BeginOrderEdit(orderID string) (*CalculatedOrder, error) {
+	gql := `
+		mutation orderEditBegin($id: ID!) {
+			orderEditBegin(id: $id) {
+				calculatedOrder {` + calculatedOrderFields + `}
+				userErrors { field message }
+			}
+		}`
+	return c.doOrderEdit(gql, "orderEditBegin", map[string]any{"id": ToGID("Order", orderID)})
+}
+
+// OrderEditAddVariant stages a new line item for a variant.
+func (c *Client) OrderEditAddVariant(calculatedOrderID, variantID string, quantity int) (*CalculatedOrder, error) {
+	gql := `
+		mutation orderEditAddVariant($id: ID!, $variantId: ID!, $quantity: Int!) {
+			orderEditAddVariant(id: $id, variantId: $variantId, quantity: $quantity, allowDuplicates: true) {
+				calculatedOrder {` + calculatedOrderFields + `}
+				userErrors { field message }
+			}
+		}`
+	return c.doOrderEdit(gql, "orderEditAddVariant", map[string]any{
+		"id":        calculatedOrderID,
+		"variantId": ToGID("ProductVariant", variantID),
+		"quantity":  quantity,
+	})
+}
+
+// OrderEditSetQuantity stages a quantity change on a line item. A quantity of 0 removes it.
+func (c *Client) OrderEditSetQuantity(calculatedOrderID, lineItemID string, quantity int, restock bool) (*CalculatedOrder, error) {
+	gql := `
+		mutation orderEditSetQuantity($id: ID!, $lineItemId: ID!, $quantity: Int!, $restock: Boolean) {
+			orderEditSetQuantity(id: $id, lineItemId: $lineItemId, quantity: $quantity, restock: $restock) {
+				calculatedOrder {` + calculatedOrderFields + `}
+				userErrors { field message }
+			}
+		}`
+	return c.doOrderEdit(gql, "orderEditSetQuantity", map[string]any{
+		"id":         calculatedOrderID,
+		"lineItemId": ToGID("CalculatedLineItem", lineItemID),
+		"quantity":   quantity,
+		"restock":    restock,
+	})
+}
+
+// OrderEditAddLineItemDiscount stages a discount on a line item.
+func (c *Client) OrderEditAddLineItemDiscount(calculatedOrderID, lineItemID string, discount OrderEditDiscount) (*CalculatedOrder, error) {
+	gql := `
+		mutation orderEditAddLineItemDiscount($id: ID!, $lineItemId: ID!, $discount: OrderEditAppliedDiscountInput!) {
+			orderEditAddLineItemDiscount(id: $id, lineItemId: $lineItemId, discount: $discount) {
+				calculatedOrder {` + calculatedOrderFields + `}
+				userErrors { field message }
+			}
+		}`
+	input := map[string]any{}
+	if discount.Description != "" {
+		input["description"] = discount.Description
+	}
+	if discount.FixedAmount != "" {
+		input["fixedValue"] = map[string]any{"amount": discount.FixedAmount, "currencyCode": discount.CurrencyCode}
+	} else {
+		input["percentValue"] = discount.Percent
+	}
+	return c.doOrderEdit(gql, "orderEditAddLineItemDiscount", map[string]any{
+		"id":         calculatedOrderID,
+		"lineItemId": ToGID("CalculatedLineItem", lineItemID),
+		"discount":   input,
+	})
+}
+
+// CommitOrderEdit applies the staged changes of an order edit to the order.
+func (c *Client) CommitOrderEdit(calculatedOrderID string, notifyCustomer bool, staffNote string) (*Order, error) {
+	const gql = `
+		mutation orderEditCommit($id: ID!, $notifyCustomer: Boolean, $staffNote: String) {
+			orderEditCommit(id: $id, notifyCustomer: $notifyCustomer, staffNote: $staffNote) {
+				order {
+					id name financialStatus
+					totalPriceSet { shopMoney { amount currencyCode } }
+				}
+				userErrors { field message }
+			}
+		}`
+	vars := map[string]any{"id": calculatedOrderID, "notifyCustomer": notifyCustomer}
+	if staffNote != "" {
+		vars["staffNote"] = staffNote
+	}
+	resp, err := c.Do(gql, vars)
+	if err != nil {
+		return nil, err
+	}
+	var data struct {
+		OrderEditCommit struct {
+			Order      *Order      `json:"order"`
+			UserErrors []UserError `json:"userErrors"`
+		} `json:"orderEditCommit"`
+	}
+	if err := json.Unmarshal(resp.Data, &data); err != nil {
+		return nil, fmt.Errorf("parsing response: %w", err)
+	}
+	if err := userErrorsToError(data.OrderEditCommit.UserErrors); err != nil {
+		return nil, err
+	}
+	return data.OrderEditCommit.Order, nil
+}
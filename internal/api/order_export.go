@@ -0,0 +1,342 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+const taxLineFields = `title ratePercentage priceSet { shopMoney { amount currencyCode } }`
+
+const discountAllocationFields = `
+	allocatedAmountSet { shopMoney { amount currencyCode } }
+	discountApplication {
+		... on DiscountCodeApplication { code }
+		... on AutomaticDiscountApplication { title }
+		... on ManualDiscountApplication { title }
+		... on ScriptDiscountApplication { title }
+	}`
+
+const exportLineItemFields = `
+	id title variantTitle sku vendor quantity
+	originalUnitPriceSet { shopMoney { amount currencyCode } }
+	discountAllocations {` + discountAllocationFields + `}
+	taxLines { ` + taxLineFields + ` }`
+
+const exportTransactionFields = `
+	id kind status gateway processedAt
+	amountSet { shopMoney { amount currencyCode } }
+	fees {
+		type rateName
+		amount { amount currencyCode }
+		taxAmount { amount currencyCode }
+	}`
+
+// exportPageTransactions is how many payment transactions ExportOrders reads per
+// order in the page query; orders with that many are re-read by
+// CompleteExportOrder, which reads up to exportTransactionsLimit.
+const (
+	exportPageTransactions  = 2
+	exportTransactionsLimit = 100
+)
+
+// ExportLimitError reports an order with more of something than export can read,
+// such as more than 100 payment transactions. The rest of the export is unaffected.
+type ExportLimitError struct {
+	Order  string
+	Reason string
+}
+
+func (e *ExportLimitError) Error() string {
+	return fmt.Sprintf("order %s %s", e.Order, e.Reason)
+}
+
+// ExportOrders returns a page of orders for export with their first line item,
+// shipping line, and transactions, and the IDs of their refunds. Each order costs
+// about 40 points, so keep first at 25 or less, and pass each order to
+// CompleteExportOrder before using it.
+func (c *Client) ExportOrders(first int, after, query string) (*ExportOrderConnection, error) {
+	gql := `
+		query ExportOrders($first: Int!, $after: String, $query: String, $transactions: Int!) {
+			orders(first: $first, after: $after, query: $query, sortKey: PROCESSED_AT) {
+				edges {
+					cursor
+					node {
+						id name createdAt processedAt currencyCode taxesIncluded email discountCodes
+						totalPriceSet { shopMoney { amount currencyCode } }
+						lineItems(first: 1) {
+							edges { node {` + exportLineItemFields + `} }
+							pageInfo { hasNextPage endCursor }
+						}
+						shippingLines(first: 1) {
+							edges {
+								node {
+									title code
+									originalPriceSet { shopMoney { amount currencyCode } }
+									discountedPriceSet { shopMoney { amount currencyCode } }
+									taxLines { ` + taxLineFields + ` }
+								}
+							}
+							pageInfo { hasNextPage endCursor }
+						}
+						refunds { id }
+						transactions(first: $transactions) {` + exportTransactionFields + `}
+					}
+				}
+				pageInfo { hasNextPage endCursor }
+			}
+		}`
+	vars := map[string]any{"first": first, "transactions": exportPageTransactions}
+	if after != "" {
+		vars["after"] = after
+	}
+	if query != "" {
+		vars["query"] = query
+	}
+	resp, err := c.Do(gql, vars)
+	if err != nil {
+		return nil, err
+	}
+	var data struct {
+		Orders ExportOrderConnection `json:"orders"`
+	}
+	if err := json.Unmarshal(resp.Data, &data); err != nil {
+		return nil, fmt.Errorf("parsing orders: %w", err)
+	}
+	return &data.Orders, nil
+}
+
+// CompleteExportOrder fetches what ExportOrders leaves out: the rest of an order's
+// line items and shipping lines, shipping discounts, further transactions, and
+// its refunds. An order with more than export can read returns an
+// *ExportLimitError.
+func (c *Client) CompleteExportOrder(o *ExportOrder) error {
+	for o.LineItems.PageInfo.HasNextPage {
+		conn, err := c.exportLineItems(o.ID, o.LineItems.PageInfo.EndCursor)
+		if err != nil {
+			return err
+		}
+		o.LineItems.Edges = append(o.LineItems.Edges, conn.Edges...)
+		o.LineItems.PageInfo = conn.PageInfo
+	}
+	if o.ShippingLines.PageInfo.HasNextPage || shippingDiscounted(o.ShippingLines) {
+		conn, err := c.exportShippingLines(o.ID)
+		if err != nil {
+			return err
+		}
+		o.ShippingLines = *conn
+	}
+	if len(o.Transactions) >= exportPageTransactions {
+		transactions, err := c.exportTransactions(o.ID)
+		if err != nil {
+			return err
+		}
+		if len(transactions) > exportTransactionsLimit {
+			return &ExportLimitError{Order: o.Name, Reason: fmt.Sprintf("has more than %d transactions", exportTransactionsLimit)}
+		}
+		o.Transactions = transactions
+	}
+	for i := range o.Refunds {
+		r, err := c.exportRefund(o.Refunds[i].ID)
+		if err != nil {
+			return err
+		}
+		if r.RefundShippingLines.PageInfo.HasNextPage {
+			return &ExportLimitError{Order: o.Name, Reason: fmt.Sprintf("has a refund (%s) with more than 10 shipping lines", ShortID(r.ID))}
+		}
+		if r.Transactions.PageInfo.HasNextPage {
+			return &ExportLimitError{Order: o.Name, Reason: fmt.Sprintf("has a refund (%s) with more than 50 transactions", ShortID(r.ID))}
+		}
+		o.Refunds[i] = *r
+	}
+	return nil
+}
+
+// shippingDiscounted reports whether any shipping line has a discount, whose
+// allocations ExportOrders doesn't read.
+func shippingDiscounted(conn ExportShippingLineConnection) bool {
+	for _, e := range conn.Edges {
+		if e.Node.OriginalPriceSet.ShopMoney.Amount != e.Node.DiscountedPriceSet.ShopMoney.Amount {
+			return true
+		}
+	}
+	return false
+}
+
+// exportLineItems returns the next page of an order's line items.
+func (c *Client) exportLineItems(orderID, after string) (*ExportLineItemConnection, error) {
+	gql := `
+		query ExportLineItems($id: ID!, $after: String) {
+			order(id: $id) {
+				lineItems(first: 25, after: $after) {
+					edges { node {` + exportLineItemFields + `} }
+					pageInfo { hasNextPage endCursor }
+				}
+			}
+		}`
+	resp, err := c.Do(gql, map[string]any{"id": orderID, "after": after})
+	if err != nil {
+		return nil, err
+	}
+	var data struct {
+		Order *struct {
+			LineItems ExportLineItemConnection `json:"lineItems"`
+		} `json:"order"`
+	}
+	if err := json.Unmarshal(resp.Data, &data); err != nil {
+		return nil, fmt.Errorf("parsing line items: %w", err)
+	}
+	if data.Order == nil {
+		return nil, fmt.Errorf("order %s not found", orderID)
+	}
+	return &data.Order.LineItems, nil
+}
+
+// exportShippingLines returns all of an order's shipping lines with their
+// discount allocations.
+func (c *Client) exportShippingLines(orderID string) (*ExportShippingLineConnection, error) {
+	gql := `
+		query ExportShippingLines($id: ID!, $after: String) {
+			order(id: $id) {
+				shippingLines(first: 25, after: $after) {
+					edges {
+						node {
+							title code
+							originalPriceSet { shopMoney { amount currencyCode } }
+							discountedPriceSet { shopMoney { amount currencyCode } }
+							discountAllocations {` + discountAllocationFields + `}
+							taxLines { ` + taxLineFields + ` }
+						}
+					}
+					pageInfo { hasNextPage endCursor }
+				}
+			}
+		}`
+	var lines ExportShippingLineConnection
+	after := ""
+	for {
+		vars := map[string]any{"id": orderID}
+		if after != "" {
+			vars["after"] = after
+		}
+		resp, err := c.Do(gql, vars)
+		if err != nil {
+			return nil, err
+		}
+		var data struct {
+			Order *struct {
+				ShippingLines ExportShippingLineConnection `json:"shippingLines"`
+			} `json:"order"`
+		}
+		if err := json.Unmarshal(resp.Data, &data); err != nil {
+			return nil, fmt.Errorf("parsing shipping lines: %w", err)
+		}
+		if data.Order == nil {
+			return nil, fmt.Errorf("order %s not found", orderID)
+		}
+		lines.Edges = append(lines.Edges, data.Order.ShippingLines.Edges...)
+		lines.PageInfo = data.Order.ShippingLines.PageInfo
+		if !lines.PageInfo.HasNextPage {
+			return &lines, nil
+		}
+		after = lines.PageInfo.EndCursor
+	}
+}
+
+// exportTransactions returns up to exportTransactionsLimit+1 of an order's
+// payment transactions, so more than the limit can be told apart from exactly it.
+// Order.transactions can't be paged.
+func (c *Client) exportTransactions(orderID string) ([]ExportTransaction, error) {
+	gql := `
+		query ExportTransactions($id: ID!, $first: Int!) {
+			order(id: $id) {
+				transactions(first: $first) {` + exportTransactionFields + `}
+			}
+		}`
+	resp, err := c.Do(gql, map[string]any{"id": orderID, "first": exportTransactionsLimit + 1})
+	if err != nil {
+		return nil, err
+	}
+	var data struct {
+		Order *struct {
+			Transactions []ExportTransaction `json:"transactions"`
+		} `json:"order"`
+	}
+	if err := json.Unmarshal(resp.Data, &data); err != nil {
+		return nil, fmt.Errorf("parsing transactions: %w", err)
+	}
+	if data.Order == nil {
+		return nil, fmt.Errorf("order %s not found", orderID)
+	}
+	return data.Order.Transactions, nil
+}
+
+// exportRefund returns a refund with all of its refunded line items.
+func (c *Client) exportRefund(id string) (*ExportRefund, error) {
+	gql := `
+		query ExportRefund($id: ID!, $after: String) {
+			refund(id: $id) {
+				id createdAt note
+				totalRefundedSet { shopMoney { amount currencyCode } }
+				refundLineItems(first: 25, after: $after) {
+					edges {
+						node {
+							lineItem { id title sku }
+							quantity
+							subtotalSet { shopMoney { amount currencyCode } }
+							totalTaxSet { shopMoney { amount currencyCode } }
+						}
+					}
+					pageInfo { hasNextPage endCursor }
+				}
+				refundShippingLines(first: 10) {
+					edges {
+						node {
+							subtotalAmountSet { shopMoney { amount currencyCode } }
+							taxAmountSet { shopMoney { amount currencyCode } }
+						}
+					}
+					pageInfo { hasNextPage }
+				}
+				transactions(first: 50) {
+					edges {
+						node {
+							id kind status gateway createdAt
+							amountSet { shopMoney { amount currencyCode } }
+						}
+					}
+					pageInfo { hasNextPage }
+				}
+			}
+		}`
+	var refund *ExportRefund
+	after := ""
+	for {
+		vars := map[string]any{"id": id}
+		if after != "" {
+			vars["after"] = after
+		}
+		resp, err := c.Do(gql, vars)
+		if err != nil {
+			return nil, err
+		}
+		var data struct {
+			Refund *ExportRefund `json:"refund"`
+		}
+		if err := json.Unmarshal(resp.Data, &data); err != nil {
+			return nil, fmt.Errorf("parsing refund: %w", err)
+		}
+		if data.Refund == nil {
+			return nil, fmt.Errorf("refund %s not found", id)
+		}
+		if refund == nil {
+			refund = data.Refund
+		} else {
+			refund.RefundLineItems.Edges = append(refund.RefundLineItems.Edges, data.Refund.RefundLineItems.Edges...)
+		}
+		if !data.Refund.RefundLineItems.PageInfo.HasNextPage {
+			refund.RefundLineItems.PageInfo = data.Refund.RefundLineItems.PageInfo
+			return refund, nil
+		}
+		after = data.Refund.RefundLineItems.PageInfo.EndCursor
+	}
+}
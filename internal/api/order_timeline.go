@@ -0,0 +1,54 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// GetOrderTimeline returns an order's events (oldest first) together with its
+// fulfillments, refunds, and transactions.
+func (c *Client) GetOrderTimeline(id string) (*OrderTimeline, error) {
+	const gql = `
+		query GetOrderTimeline($id: ID!) {
+			order(id: $id) {
+				id name note createdAt closedAt cancelledAt cancelReason
+				events(first: 250, sortKey: CREATED_AT) {
+					edges {
+						node {
+							id createdAt message appTitle attributeToApp attributeToUser criticalAlert
+							... on BasicEvent { action }
+							... on CommentEvent { rawMessage author { name } }
+						}
+					}
+					pageInfo { hasNextPage endCursor }
+				}
+				fulfillments(first: 50) {
+					id name status displayStatus createdAt deliveredAt
+					location { id name }
+					trackingInfo { company number url }
+				}
+				refunds {
+					id createdAt note
+					totalRefundedSet { shopMoney { amount currencyCode } }
+				}
+				transactions(first: 100) {
+					id kind status gateway createdAt errorCode
+					amountSet { shopMoney { amount currencyCode } }
+				}
+			}
+		}`
+	resp, err := c.Do(gql, map[string]any{"id": ToGID("Order", id)})
+	if err != nil {
+		return nil, err
+	}
+	var data struct {
+		Order *OrderTimeline `json:"order"`
+	}
+	if err := json.Unmarshal(resp.Data, &data); err != nil {
+		return nil, fmt.Errorf("parsing order timeline: %w", err)
+	}
+	if data.Order == nil {
+		return nil, fmt.Errorf("order %s not found", id)
+	}
+	return data.Order, nil
+}
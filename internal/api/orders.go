@@ -10,6 +10,43 @@ func (c *Client) ListOrders(first int, after, query string) (*OrderConnection, e
 	const gql = `
 		query ListOrders($first: Int!, $after: String, $query: String) {
 			orders(first: $first, after: $after, query: $query) {
+				edges {
+					cursor
+					node {
+						id name email financialStatus displayFulfillmentStatus
+						totalPriceSet { shopMoney { amount currencyCode } }
+						createdAt note
+						customer { firstName lastName }
+					}
+				}
+				pageInfo { hasNextPage endCursor }
+			}
+		}`
+	vars := map[string]any{"first": first}
+	if after != "" {
+		vars["after"] = after
+	}
+	if query != "" {
+		vars["query"] = query
+	}
+	resp, err := c.Do(gql, vars)
+	if err != nil {
+		return nil, err
+	}
+	var data struct {
+		Orders OrderConnection `json:"orders"`
+	}
+	if err := json.Unmarshal(resp.Data, &data); err != nil {
+		return nil, fmt.Errorf("parsing orders: %w", err)
+	}
+	return &data.Orders, nil
+}
+
+// ListOrdersWithRisk returns a paginated list of orders with their risk assessments.
+func (c *Client) ListOrdersWithRisk(first int, after, query string) (*OrderConnection, error) {
+	const gql = `
+		query ListOrdersWithRisk($first: Int!, $after: String, $query: String) {
+			orders(first: $first, after: $after, query: $query, sortKey: CREATED_AT, reverse: true) {
 				edges {
 					cursor
 					node {
@@ -17,6 +54,14 @@ func (c *Client) ListOrders(first int, after, query string) (*OrderConnection, e
 						totalPriceSet { shopMoney { amount currencyCode } }
 						createdAt
 						customer { firstName lastName }
+						risk {
+							recommendation
+							assessments {
+								riskLevel
+								provider { title }
+								facts { description sentiment }
+							}
+						}
 					}
 				}
 				pageInfo { hasNextPage endCursor }
@@ -42,9 +87,16 @@ func (c *Client) ListOrders(first int, after, query string) (*OrderConnection, e
 	return &data.Orders, nil
 }
 
-// GetOrder returns a single order by ID with full details.
+const orderLineItemFields = `
+	id title variantTitle quantity sku refundableQuantity unfulfilledQuantity
+	image { url altText }
+	originalUnitPriceSet { shopMoney { amount currencyCode } }
+	discountedTotalSet { shopMoney { amount currencyCode } }`
+
+// GetOrder returns a single order by ID with full details, including all of its
+// line items.
 func (c *Client) GetOrder(id string) (*Order, error) {
-	const gql = `
+	gql := `
 		query GetOrder($id: ID!) {
 			order(id: $id) {
 				id name email phone
@@ -52,19 +104,30 @@ func (c *Client) GetOrder(id string) (*Order, error) {
 				totalPriceSet { shopMoney { amount currencyCode } }
 				subtotalPriceSet { shopMoney { amount currencyCode } }
 				totalTaxSet { shopMoney { amount currencyCode } }
+				totalShippingPriceSet { shopMoney { amount currencyCode } }
+				totalDiscountsSet { shopMoney { amount currencyCode } }
 				createdAt processedAt note tags
+				customAttributes { key value }
+				risk {
+					recommendation
+					assessments {
+						riskLevel
+						provider { title }
+						facts { description sentiment }
+					}
+				}
 				customer { id firstName lastName email }
 				shippingAddress {
 					firstName lastName address1 address2
 					city province zip country phone
 				}
+				billingAddress {
+					firstName lastName address1 address2
+					city province zip country phone
+				}
 				lineItems(first: 50) {
-					edges {
-						node {
-							id title quantity sku
-							originalUnitPriceSet { shopMoney { amount currencyCode } }
-						}
-					}
+					edges { node {` + orderLineItemFields + `} }
+					pageInfo { hasNextPage endCursor }
 				}
 			}
 		}`
@@ -81,7 +144,45 @@ func (c *Client) GetOrder(id string) (*Order, error) {
 	if data.Order == nil {
 		return nil, fmt.Errorf("order %s not found", id)
 	}
-	return data.Order, nil
+	o := data.Order
+	for o.LineItems.PageInfo.HasNextPage {
+		conn, err := c.orderLineItems(o.ID, o.LineItems.PageInfo.EndCursor)
+		if err != nil {
+			return nil, err
+		}
+		o.LineItems.Edges = append(o.LineItems.Edges, conn.Edges...)
+		o.LineItems.PageInfo = conn.PageInfo
+	}
+	return o, nil
+}
+
+// orderLineItems returns the next page of an order's line items.
+func (c *Client) orderLineItems(orderID, after string) (*LineItemConnection, error) {
+	gql := `
+		query OrderLineItems($id: ID!, $after: String) {
+			order(id: $id) {
+				lineItems(first: 50, after: $after) {
+					edges { node {` + orderLineItemFields + `} }
+					pageInfo { hasNextPage endCursor }
+				}
+			}
+		}`
+	resp, err := c.Do(gql, map[string]any{"id": orderID, "after": after})
+	if err != nil {
+		return nil, err
+	}
+	var data struct {
+		Order *struct {
+			LineItems LineItemConnection `json:"lineItems"`
+		} `json:"order"`
+	}
+	if err := json.Unmarshal(resp.Data, &data); err != nil {
+		return nil, fmt.Errorf("parsing line items: %w", err)
+	}
+	if data.Order == nil {
+		return nil, fmt.Errorf("order %s not found", orderID)
+	}
+	return &data.Order.LineItems, nil
 }
 
 // CloseOrder marks an order as closed.
@@ -172,3 +273,40 @@ func (c *Client) MarkOrderAsPaid(id string) (*Order, error) {
 	}
 	return data.OrderMarkAsPaid.Order, nil
 }
+
+// UpdateOrder updates an order from an OrderInput (note, email, tags,
+// shippingAddress, customAttributes). Tags and customAttributes replace the existing lists.
+func (c *Client) UpdateOrder(id string, input map[string]any) (*Order, error) {
+	const gql = `
+		mutation orderUpdate($input: OrderInput!) {
+			orderUpdate(input: $input) {
+				order {
+					id name email note tags
+					customAttributes { key value }
+					shippingAddress {
+						firstName lastName address1 address2
+						city province zip country phone
+					}
+				}
+				userErrors { field message }
+			}
+		}`
+	input["id"] = ToGID("Order", id)
+	resp, err := c.Do(gql, map[string]any{"input": input})
+	if err != nil {
+		return nil, err
+	}
+	var data struct {
+		OrderUpdate struct {
+			Order      *Order      `json:"order"`
+			UserErrors []UserError `json:"userErrors"`
+		} `json:"orderUpdate"`
+	}
+	if err := json.Unmarshal(resp.Data, &data); err != nil {
+		return nil, fmt.Errorf("parsing response: %w", err)
+	}
+	if err := userErrorsToError(data.OrderUpdate.UserErrors); err != nil {
+		return nil, err
+	}
+	return data.OrderUpdate.Order, nil
+}
@@ -0,0 +1,202 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// PriceListFixedPrice is one fixed price to set on a price list.
+// CompareAtPrice is optional.
+type PriceListFixedPrice struct {
+	VariantID      string
+	Price          string
+	CompareAtPrice string
+}
+
+// ListPriceLists returns a paginated list of price lists.
+func (c *Client) ListPriceLists(first int, after string) (*PriceListConnection, error) {
+	const gql = `
+		query ListPriceLists($first: Int!, $after: String) {
+			priceLists(first: $first, after: $after) {
+				edges {
+					cursor
+					node {
+						id name currency fixedPricesCount
+						parent { adjustment { type value } }
+						catalog { id title }
+					}
+				}
+				pageInfo { hasNextPage endCursor }
+			}
+		}`
+	vars := map[string]any{"first": first}
+	if after != "" {
+		vars["after"] = after
+	}
+	resp, err := c.Do(gql, vars)
+	if err != nil {
+		return nil, err
+	}
+	var data struct {
+		PriceLists PriceListConnection `json:"priceLists"`
+	}
+	if err := json.Unmarshal(resp.Data, &data); err != nil {
+		return nil, fmt.Errorf("parsing price lists: %w", err)
+	}
+	return &data.PriceLists, nil
+}
+
+// GetPriceList returns a price list with a page of its fixed prices.
+func (c *Client) GetPriceList(id string, first int, after string) (*PriceList, error) {
+	const gql = `
+		query GetPriceList($id: ID!, $first: Int!, $after: String) {
+			priceList(id: $id) {
+				id name currency fixedPricesCount
+				parent { adjustment { type value } }
+				catalog { id title }
+				prices(first: $first, after: $after, originType: FIXED) {
+					edges {
+						cursor
+						node {
+							originType
+							price { amount currencyCode }
+							compareAtPrice { amount currencyCode }
+							variant { id sku displayName }
+						}
+					}
+					pageInfo { hasNextPage endCursor }
+				}
+			}
+		}`
+	vars := map[string]any{"id": ToGID("PriceList", id), "first": first}
+	if after != "" {
+		vars["after"] = after
+	}
+	resp, err := c.Do(gql, vars)
+	if err != nil {
+		return nil, err
+	}
+	var data struct {
+		PriceList *PriceList `json:"priceList"`
+	}
+	if err := json.Unmarshal(resp.Data, &data); err != nil {
+		return nil, fmt.Errorf("parsing price list: %w", err)
+	}
+	if data.PriceList == nil {
+		return nil, fmt.Errorf("price list %s not found", id)
+	}
+	return data.PriceList, nil
+}
+
+// CreatePriceList creates a price list in currency. adjustment is a percentage applied
+// to base prices: negative values decrease prices, positive values increase them.
+// catalogID is optional.
+func (c *Client) CreatePriceList(name, currency string, adjustment float64, catalogID string) (*PriceList, error) {
+	const gql = `
+		mutation priceListCreate($input: PriceListCreateInput!) {
+			priceListCreate(input: $input) {
+				priceList {
+					id name currency fixedPricesCount
+					parent { adjustment { type value } }
+					catalog { id title }
+				}
+				userErrors { field message }
+			}
+		}`
+	adjType, adjValue := "PERCENTAGE_DECREASE", -adjustment
+	if adjustment > 0 {
+		adjType, adjValue = "PERCENTAGE_INCREASE", adjustment
+	}
+	input := map[string]any{
+		"name":     name,
+		"currency": currency,
+		"parent": map[string]any{
+			"adjustment": map[string]any{"type": adjType, "value": adjValue},
+		},
+	}
+	if catalogID != "" {
+		input["catalogId"] = ToGID("MarketCatalog", catalogID)
+	}
+	resp, err := c.Do(gql, map[string]any{"input": input})
+	if err != nil {
+		return nil, err
+	}
+	var data struct {
+		PriceListCreate struct {
+			PriceList  *PriceList  `json:"priceList"`
+			UserErrors []UserError `json:"userErrors"`
+		} `json:"priceListCreate"`
+	}
+	if err := json.Unmarshal(resp.Data, &data); err != nil {
+		return nil, fmt.Errorf("parsing response: %w", err)
+	}
+	if err := userErrorsToError(data.PriceListCreate.UserErrors); err != nil {
+		return nil, err
+	}
+	return data.PriceListCreate.PriceList, nil
+}
+
+// AddPriceListFixedPrices sets fixed prices (in the price list currency) for variants.
+// Existing fixed prices for the same variants are replaced.
+func (c *Client) AddPriceListFixedPrices(priceListID, currency string, prices []PriceListFixedPrice) error {
+	const gql = `
+		mutation priceListFixedPricesAdd($priceListId: ID!, $prices: [PriceListPriceInput!]!) {
+			priceListFixedPricesAdd(priceListId: $priceListId, prices: $prices) {
+				prices { variant { id } }
+				userErrors { field message }
+			}
+		}`
+	inputs := make([]map[string]any, len(prices))
+	for i, p := range prices {
+		in := map[string]any{
+			"variantId": ToGID("ProductVariant", p.VariantID),
+			"price":     map[string]any{"amount": p.Price, "currencyCode": currency},
+		}
+		if p.CompareAtPrice != "" {
+			in["compareAtPrice"] = map[string]any{"amount": p.CompareAtPrice, "currencyCode": currency}
+		}
+		inputs[i] = in
+	}
+	resp, err := c.Do(gql, map[string]any{"priceListId": ToGID("PriceList", priceListID), "prices": inputs})
+	if err != nil {
+		return err
+	}
+	var data struct {
+		PriceListFixedPricesAdd struct {
+			UserErrors []UserError `json:"userErrors"`
+		} `json:"priceListFixedPricesAdd"`
+	}
+	if err := json.Unmarshal(resp.Data, &data); err != nil {
+		return fmt.Errorf("parsing response: %w", err)
+	}
+	return userErrorsToError(data.PriceListFixedPricesAdd.UserErrors)
+}
+
+// DeletePriceListFixedPrices removes fixed prices so the variants fall back to the
+// price list's percentage adjustment.
+func (c *Client) DeletePriceListFixedPrices(priceListID string, variantIDs []string) error {
+	const gql = `
+		mutation priceListFixedPricesDelete($priceListId: ID!, $variantIds: [ID!]!) {
+			priceListFixedPricesDelete(priceListId: $priceListId, variantIds: $variantIds) {
+				deletedFixedPriceVariantIds
+				userErrors { field message }
+			}
+		}`
+	gids := make([]string, len(variantIDs))
+	for i, id := range variantIDs {
+		gids[i] = ToGID("ProductVariant", id)
+	}
+	resp, err := c.Do(gql, map[string]any{"priceListId": ToGID("PriceList", priceListID), "variantIds": gids})
+	if err != nil {
+		return err
+	}
+	var data struct {
+		PriceListFixedPricesDelete struct {
+			UserErrors []UserError `json:"userErrors"`
+		} `json:"priceListFixedPricesDelete"`
+	}
+	if err := json.Unmarshal(resp.Data, &data); err != nil {
+		return fmt.Errorf("parsing response: %w", err)
+	}
+	return userErrorsToError(data.PriceListFixedPricesDelete.UserErrors)
+}
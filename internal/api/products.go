@@ -56,6 +56,9 @@ func (c *Client) GetProduct(id string) (*Product, error) {
 						}
 					}
 				}
+				sellingPlanGroups(first: 20) {
+					edges { node { id name summary } }
+				}
 			}
 		}`
 	resp, err := c.Do(gql, map[string]any{"id": ToGID("Product", id)})
@@ -255,3 +258,50 @@ func (c *Client) UpdateVariant(id, price, sku, barcode string) (*ProductVariant,
 	}
 	return data.ProductVariantUpdate.ProductVariant, nil
 }
+
+// GetContextualPrices returns the variant prices a buyer in country sees for a product.
+func (c *Client) GetContextualPrices(productID, country string) ([]VariantContextualPrice, error) {
+	const gql = `
+		query ContextualPrices($id: ID!, $context: ContextualPricingContext!) {
+			product(id: $id) {
+				variants(first: 100) {
+					edges {
+						node {
+							id title sku price
+							contextualPricing(context: $context) {
+								price { amount currencyCode }
+								compareAtPrice { amount currencyCode }
+							}
+						}
+					}
+				}
+			}
+		}`
+	resp, err := c.Do(gql, map[string]any{
+		"id":      ToGID("Product", productID),
+		"context": map[string]any{"country": country},
+	})
+	if err != nil {
+		return nil, err
+	}
+	var data struct {
+		Product *struct {
+			Variants struct {
+				Edges []struct {
+					Node VariantContextualPrice `json:"node"`
+				} `json:"edges"`
+			} `json:"variants"`
+		} `json:"product"`
+	}
+	if err := json.Unmarshal(resp.Data, &data); err != nil {
+		return nil, fmt.Errorf("parsing product: %w", err)
+	}
+	if data.Product == nil {
+		return nil, fmt.Errorf("product %s not found", productID)
+	}
+	prices := make([]VariantContextualPrice, len(data.Product.Variants.Edges))
+	for i, e := range data.Product.Variants.Edges {
+		prices[i] = e.Node
+	}
+	return prices, nil
+}
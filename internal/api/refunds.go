@@ -0,0 +1,217 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// RefundLineItemInput is a line item to refund. RestockType is RETURN, CANCEL, or
+// NO_RESTOCK; LocationID is required when restocking.
+type RefundLineItemInput struct {
+	LineItemID  string
+	Quantity    int
+	RestockType string
+	LocationID  string
+}
+
+// RefundTransactionInput is one refund transaction against a parent sale or capture.
+type RefundTransactionInput struct {
+	ParentID string
+	Gateway  string
+	Amount   string
+}
+
+// RefundInput describes a refund to create. Shipping is refunded in full when
+// FullShipping is set, or up to ShippingAmount otherwise.
+type RefundInput struct {
+	OrderID        string
+	Note           string
+	Notify         bool
+	Currency       string
+	FullShipping   bool
+	ShippingAmount string
+	LineItems      []RefundLineItemInput
+	Transactions   []RefundTransactionInput
+}
+
+func refundLineItemsVar(lines []RefundLineItemInput) []map[string]any {
+	out := make([]map[string]any, len(lines))
+	for i, l := range lines {
+		in := map[string]any{
+			"lineItemId":  ToGID("LineItem", l.LineItemID),
+			"quantity":    l.Quantity,
+			"restockType": l.RestockType,
+		}
+		if l.LocationID != "" {
+			in["locationId"] = ToGID("Location", l.LocationID)
+		}
+		out[i] = in
+	}
+	return out
+}
+
+// SuggestRefund asks Shopify to calculate a refund for the given line items and
+// shipping, and returns it with the order's presentment currency. With suggestFull,
+// the suggestion covers everything still refundable on the order.
+func (c *Client) SuggestRefund(orderID string, lines []RefundLineItemInput, fullShipping bool, shippingAmount string, suggestFull bool) (*SuggestedRefund, string, error) {
+	const gql = `
+		query SuggestRefund($id: ID!, $lines: [RefundLineItemInput!], $refundShipping: Boolean, $shippingAmount: Money, $suggestFull: Boolean) {
+			order(id: $id) {
+				presentmentCurrencyCode
+				suggestedRefund(refundLineItems: $lines, refundShipping: $refundShipping, shippingAmount: $shippingAmount, suggestFullRefund: $suggestFull) {
+					amountSet { shopMoney { amount currencyCode } presentmentMoney { amount currencyCode } }
+					subtotalSet { shopMoney { amount currencyCode } }
+					totalTaxSet { shopMoney { amount currencyCode } }
+					maximumRefundableSet { shopMoney { amount currencyCode } }
+					shipping {
+						amountSet { shopMoney { amount currencyCode } }
+						maximumRefundableSet { shopMoney { amount currencyCode } }
+					}
+					refundLineItems {
+						lineItem { id title sku }
+						quantity restockType
+						subtotalSet { shopMoney { amount currencyCode } }
+					}
+					suggestedTransactions {
+						gateway kind
+						amountSet { shopMoney { amount currencyCode } presentmentMoney { amount currencyCode } }
+						maximumRefundableSet { shopMoney { amount currencyCode } presentmentMoney { amount currencyCode } }
+						parentTransaction { id }
+					}
+				}
+			}
+		}`
+	vars := map[string]any{
+		"id":          ToGID("Order", orderID),
+		"lines":       refundLineItemsVar(lines),
+		"suggestFull": suggestFull,
+	}
+	if fullShipping {
+		vars["refundShipping"] = true
+	} else if shippingAmount != "" {
+		vars["shippingAmount"] = shippingAmount
+	}
+	resp, err := c.Do(gql, vars)
+	if err != nil {
+		return nil, "", err
+	}
+	var data struct {
+		Order *struct {
+			PresentmentCurrencyCode string          `json:"presentmentCurrencyCode"`
+			SuggestedRefund         SuggestedRefund `json:"suggestedRefund"`
+		} `json:"order"`
+	}
+	if err := json.Unmarshal(resp.Data, &data); err != nil {
+		return nil, "", fmt.Errorf("parsing suggested refund: %w", err)
+	}
+	if data.Order == nil {
+		return nil, "", fmt.Errorf("order %s not found", orderID)
+	}
+	return &data.Order.SuggestedRefund, data.Order.PresentmentCurrencyCode, nil
+}
+
+// CreateRefund creates a refund: restocks line items, refunds shipping, and issues
+// the given refund transactions.
+func (c *Client) CreateRefund(in RefundInput) (*Refund, error) {
+	const gql = `
+		mutation refundCreate($input: RefundInput!) {
+			refundCreate(input: $input) {
+				refund {
+					id createdAt note
+					totalRefundedSet { shopMoney { amount currencyCode } }
+				}
+				userErrors { field message }
+			}
+		}`
+	orderGID := ToGID("Order", in.OrderID)
+	input := map[string]any{
+		"orderId":         orderGID,
+		"notify":          in.Notify,
+		"refundLineItems": refundLineItemsVar(in.LineItems),
+	}
+	if in.Note != "" {
+		input["note"] = in.Note
+	}
+	if in.Currency != "" {
+		input["currency"] = in.Currency
+	}
+	if in.FullShipping {
+		input["shipping"] = map[string]any{"fullRefund": true}
+	} else if in.ShippingAmount != "" {
+		input["shipping"] = map[string]any{"amount": in.ShippingAmount}
+	}
+	txs := make([]map[string]any, len(in.Transactions))
+	for i, t := range in.Transactions {
+		txs[i] = map[string]any{
+			"orderId":  orderGID,
+			"parentId": t.ParentID,
+			"gateway":  t.Gateway,
+			"kind":     "REFUND",
+			"amount":   t.Amount,
+		}
+	}
+	input["transactions"] = txs
+	resp, err := c.Do(gql, map[string]any{"input": input})
+	if err != nil {
+		return nil, err
+	}
+	var data struct {
+		RefundCreate struct {
+			Refund     *Refund     `json:"refund"`
+			UserErrors []UserError `json:"userErrors"`
+		} `json:"refundCreate"`
+	}
+	if err := json.Unmarshal(resp.Data, &data); err != nil {
+		return nil, fmt.Errorf("parsing response: %w", err)
+	}
+	if err := userErrorsToError(data.RefundCreate.UserErrors); err != nil {
+		return nil, err
+	}
+	return data.RefundCreate.Refund, nil
+}
+
+// ListRefunds returns all refunds issued on an order.
+func (c *Client) ListRefunds(orderID string) ([]Refund, error) {
+	const gql = `
+		query ListRefunds($id: ID!) {
+			order(id: $id) {
+				refunds {
+					id createdAt note
+					totalRefundedSet { shopMoney { amount currencyCode } }
+					refundLineItems(first: 50) {
+						edges {
+							node {
+								lineItem { id title sku }
+								quantity restockType
+								subtotalSet { shopMoney { amount currencyCode } }
+							}
+						}
+					}
+					transactions(first: 10) {
+						edges {
+							node {
+								id kind status gateway createdAt
+								amountSet { shopMoney { amount currencyCode } }
+							}
+						}
+					}
+				}
+			}
+		}`
+	resp, err := c.Do(gql, map[string]any{"id": ToGID("Order", orderID)})
+	if err != nil {
+		return nil, err
+	}
+	var data struct {
+		Order *struct {
+			Refunds []Refund `json:"refunds"`
+		} `json:"order"`
+	}
+	if err := json.Unmarshal(resp.Data, &data); err != nil {
+		return nil, fmt.Errorf("parsing refunds: %w", err)
+	}
+	if data.Order == nil {
+		return nil, fmt.Errorf("order %s not found", orderID)
+	}
+	return data.Order.Refunds, nil
+}
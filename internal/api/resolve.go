@@ -0,0 +1,302 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Reference forms accepted by Resolve, besides GIDs and numeric IDs:
+//
+//	sku:ABC-1          variant SKU        → ProductVariant, Product, InventoryItem
+//	barcode:012345     variant barcode    → ProductVariant, Product, InventoryItem
+//	handle:blue-shirt  handle             → Product, Collection
+//	#1001              order name         → Order (#D12 for DraftOrder)
+//	jane@example.com   customer email     → Customer
+
+// InferRefType returns the resource type a reference points to by default,
+// or "" when the reference does not imply one (plain numeric IDs).
+func InferRefType(ref string) string {
+	ref = strings.TrimSpace(ref)
+	switch {
+	case strings.HasPrefix(ref, "gid://"):
+		parts := strings.Split(strings.TrimPrefix(ref, "gid://shopify/"), "/")
+		return parts[0]
+	case hasRefPrefix(ref, "sku:"), hasRefPrefix(ref, "barcode:"):
+		return "ProductVariant"
+	case hasRefPrefix(ref, "handle:"):
+		return "Product"
+	case strings.HasPrefix(ref, "#D"):
+		return "DraftOrder"
+	case strings.HasPrefix(ref, "#"):
+		return "Order"
+	case strings.Contains(ref, "@"):
+		return "Customer"
+	}
+	return ""
+}
+
+// Resolve turns a flexible reference (GID, numeric ID, sku:, barcode:, handle:,
+// #order-name or customer email) into a GID of resourceType. Non-numeric forms are
+// looked up with search queries; a reference matching more than one resource, or a
+// GID of another type, is an error.
+func (c *Client) Resolve(resourceType, ref string) (string, error) {
+	ref = strings.TrimSpace(ref)
+	switch {
+	case ref == "":
+		return "", fmt.Errorf("empty %s reference", resourceType)
+	case strings.HasPrefix(ref, "gid://"):
+		if t := InferRefType(ref); t != resourceType {
+			return "", fmt.Errorf("%q: expected %s ID, got %s ID", ref, withArticle(resourceType), withArticle(t))
+		}
+		return ref, nil
+	case isNumericID(ref):
+		return ToGID(resourceType, ref), nil
+	case hasRefPrefix(ref, "sku:"):
+		return c.resolveVariantRef(resourceType, "sku", ref[len("sku:"):], ref)
+	case hasRefPrefix(ref, "barcode:"):
+		return c.resolveVariantRef(resourceType, "barcode", ref[len("barcode:"):], ref)
+	case hasRefPrefix(ref, "handle:"):
+		return c.resolveHandle(resourceType, ref[len("handle:"):], ref)
+	case strings.HasPrefix(ref, "#"):
+		return c.resolveOrderName(resourceType, ref)
+	case strings.Contains(ref, "@"):
+		return c.resolveEmail(resourceType, ref)
+	}
+	return "", fmt.Errorf("cannot resolve %q: expected a numeric ID, GID, sku:, barcode:, handle:, #order-name or email", ref)
+}
+
+// withArticle prefixes a resource type with "a" or "an".
+func withArticle(resourceType string) string {
+	if resourceType != "" && strings.ContainsRune("AEIOU", rune(resourceType[0])) {
+		return "an " + resourceType
+	}
+	return "a " + resourceType
+}
+
+func hasRefPrefix(ref, prefix string) bool {
+	return len(ref) > len(prefix) && strings.EqualFold(ref[:len(prefix)], prefix)
+}
+
+func isNumericID(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return s != ""
+}
+
+// searchValue quotes a value for use in a search query.
+func searchValue(v string) string {
+	return `"` + strings.ReplaceAll(v, `"`, `\"`) + `"`
+}
+
+func unsupportedRef(ref, resourceType string) error {
+	return fmt.Errorf("%q cannot be resolved to a %s", ref, resourceType)
+}
+
+func ambiguousRef(ref string, matches []string) error {
+	return fmt.Errorf("%q is ambiguous: matches %d resources (%s) — use a numeric ID instead", ref, len(matches), strings.Join(matches, ", "))
+}
+
+func (c *Client) resolveVariantRef(resourceType, field, value, ref string) (string, error) {
+	switch resourceType {
+	case "ProductVariant", "Product", "InventoryItem":
+	default:
+		return "", unsupportedRef(ref, resourceType)
+	}
+	const gql = `
+		query ResolveVariant($query: String!) {
+			productVariants(first: 10, query: $query) {
+				edges {
+					node {
+						id sku barcode displayName
+						product { id }
+						inventoryItem { id }
+					}
+				}
+			}
+		}`
+	resp, err := c.Do(gql, map[string]any{"query": field + ":" + searchValue(value)})
+	if err != nil {
+		return "", err
+	}
+	var data struct {
+		ProductVariants struct {
+			Edges []struct {
+				Node struct {
+					ID          string `json:"id"`
+					SKU         string `json:"sku"`
+					Barcode     string `json:"barcode"`
+					DisplayName string `json:"displayName"`
+					Product     struct {
+						ID string `json:"id"`
+					} `json:"product"`
+					InventoryItem struct {
+						ID string `json:"id"`
+					} `json:"inventoryItem"`
+				} `json:"node"`
+			} `json:"edges"`
+		} `json:"productVariants"`
+	}
+	if err := json.Unmarshal(resp.Data, &data); err != nil {
+		return "", fmt.Errorf("parsing variants: %w", err)
+	}
+	var ids, labels []string
+	seen := map[string]bool{}
+	for _, e := range data.ProductVariants.Edges {
+		v := e.Node
+		// The search index matches loosely; keep exact matches only.
+		if (field == "sku" && v.SKU != value) || (field == "barcode" && v.Barcode != value) {
+			continue
+		}
+		id := v.ID
+		switch resourceType {
+		case "Product":
+			id = v.Product.ID
+		case "InventoryItem":
+			id = v.InventoryItem.ID
+		}
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		ids = append(ids, id)
+		labels = append(labels, v.DisplayName)
+	}
+	switch len(ids) {
+	case 0:
+		return "", fmt.Errorf("no variant found with %s %q", field, value)
+	case 1:
+		return ids[0], nil
+	}
+	return "", ambiguousRef(ref, labels)
+}
+
+func (c *Client) resolveHandle(resourceType, handle, ref string) (string, error) {
+	var connection string
+	switch resourceType {
+	case "Product":
+		connection = "products"
+	case "Collection":
+		connection = "collections"
+	default:
+		return "", unsupportedRef(ref, resourceType)
+	}
+	gql := fmt.Sprintf(`
+		query ResolveHandle($query: String!) {
+			%s(first: 5, query: $query) {
+				edges { node { id handle } }
+			}
+		}`, connection)
+	resp, err := c.Do(gql, map[string]any{"query": "handle:" + searchValue(handle)})
+	if err != nil {
+		return "", err
+	}
+	var data map[string]struct {
+		Edges []struct {
+			Node struct {
+				ID     string `json:"id"`
+				Handle string `json:"handle"`
+			} `json:"node"`
+		} `json:"edges"`
+	}
+	if err := json.Unmarshal(resp.Data, &data); err != nil {
+		return "", fmt.Errorf("parsing %s: %w", connection, err)
+	}
+	for _, e := range data[connection].Edges {
+		if e.Node.Handle == handle {
+			return e.Node.ID, nil
+		}
+	}
+	return "", fmt.Errorf("no %s found with handle %q", strings.ToLower(resourceType), handle)
+}
+
+func (c *Client) resolveOrderName(resourceType, name string) (string, error) {
+	var connection string
+	switch resourceType {
+	case "Order":
+		connection = "orders"
+	case "DraftOrder":
+		connection = "draftOrders"
+	default:
+		return "", unsupportedRef(name, resourceType)
+	}
+	gql := fmt.Sprintf(`
+		query ResolveName($query: String!) {
+			%s(first: 5, query: $query) {
+				edges { node { id name } }
+			}
+		}`, connection)
+	resp, err := c.Do(gql, map[string]any{"query": "name:" + searchValue(name)})
+	if err != nil {
+		return "", err
+	}
+	var data map[string]struct {
+		Edges []struct {
+			Node struct {
+				ID   string `json:"id"`
+				Name string `json:"name"`
+			} `json:"node"`
+		} `json:"edges"`
+	}
+	if err := json.Unmarshal(resp.Data, &data); err != nil {
+		return "", fmt.Errorf("parsing %s: %w", connection, err)
+	}
+	var ids []string
+	for _, e := range data[connection].Edges {
+		if strings.EqualFold(e.Node.Name, name) {
+			ids = append(ids, e.Node.ID)
+		}
+	}
+	switch len(ids) {
+	case 0:
+		return "", fmt.Errorf("no %s found with name %q", strings.ToLower(resourceType), name)
+	case 1:
+		return ids[0], nil
+	}
+	return "", ambiguousRef(name, ids)
+}
+
+func (c *Client) resolveEmail(resourceType, email string) (string, error) {
+	if resourceType != "Customer" {
+		return "", unsupportedRef(email, resourceType)
+	}
+	const gql = `
+		query ResolveEmail($query: String!) {
+			customers(first: 5, query: $query) {
+				edges { node { id email } }
+			}
+		}`
+	resp, err := c.Do(gql, map[string]any{"query": "email:" + searchValue(email)})
+	if err != nil {
+		return "", err
+	}
+	var data struct {
+		Customers struct {
+			Edges []struct {
+				Node struct {
+					ID    string `json:"id"`
+					Email string `json:"email"`
+				} `json:"node"`
+			} `json:"edges"`
+		} `json:"customers"`
+	}
+	if err := json.Unmarshal(resp.Data, &data); err != nil {
+		return "", fmt.Errorf("parsing customers: %w", err)
+	}
+	var ids []string
+	for _, e := range data.Customers.Edges {
+		if strings.EqualFold(e.Node.Email, email) {
+			ids = append(ids, e.Node.ID)
+		}
+	}
+	switch len(ids) {
+	case 0:
+		return "", fmt.Errorf("no customer found with email %q", email)
+	case 1:
+		return ids[0], nil
+	}
+	return "", ambiguousRef(email, ids)
+}
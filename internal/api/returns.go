@@ -0,0 +1,562 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// returnSummaryFields is the slim field set used when listing returns.
+const returnSummaryFields = `
+	id name status createdAt closedAt totalQuantity
+	order { id name }`
+
+const returnLineItemFields = `
+	id quantity returnReason customerNote
+	... on ReturnLineItem {
+		fulfillmentLineItem { id lineItem { id title sku } }
+	}`
+
+const reverseFulfillmentOrderLineItemFields = `
+	id totalQuantity
+	fulfillmentLineItem { id lineItem { id title sku } }
+	dispositions { quantity type location { id name } }`
+
+// ReturnRequestLine is a fulfilled line item quantity to return. Reason is a
+// ReturnReason such as SIZE_TOO_SMALL, DEFECTIVE, or UNWANTED.
+type ReturnRequestLine struct {
+	FulfillmentLineItemID string
+	Quantity              int
+	Reason                string
+	CustomerNote          string
+}
+
+// ReverseDispositionInput records the outcome for units of a reverse fulfillment
+// order line item. LocationID is required for RESTOCKED.
+type ReverseDispositionInput struct {
+	LineItemID string
+	Quantity   int
+	Type       string
+	LocationID string
+}
+
+// ListOrdersWithReturns returns a paginated list of orders with up to 10 returns
+// each, summary fields only. The query is usually a return_status filter. Each
+// order costs about 25 points, so keep first at 40 or less. An order with more
+// returns is an error; list it with ListOrderReturns instead.
+func (c *Client) ListOrdersWithReturns(first int, after, query string) (*OrderConnection, error) {
+	gql := `
+		query ListOrdersWithReturns($first: Int!, $after: String, $query: String) {
+			orders(first: $first, after: $after, query: $query, sortKey: UPDATED_AT, reverse: true) {
+				edges {
+					cursor
+					node {
+						id name
+						returns(first: 10) {
+							edges { node {` + returnSummaryFields + `} }
+							pageInfo { hasNextPage }
+						}
+					}
+				}
+				pageInfo { hasNextPage endCursor }
+			}
+		}`
+	vars := map[string]any{"first": first}
+	if after != "" {
+		vars["after"] = after
+	}
+	if query != "" {
+		vars["query"] = query
+	}
+	resp, err := c.Do(gql, vars)
+	if err != nil {
+		return nil, err
+	}
+	var data struct {
+		Orders OrderConnection `json:"orders"`
+	}
+	if err := json.Unmarshal(resp.Data, &data); err != nil {
+		return nil, fmt.Errorf("parsing orders: %w", err)
+	}
+	for _, e := range data.Orders.Edges {
+		if e.Node.Returns != nil && e.Node.Returns.PageInfo.HasNextPage {
+			return nil, fmt.Errorf("order %s has more than 10 returns — list them with --order %s", e.Node.Name, ShortID(e.Node.ID))
+		}
+	}
+	return &data.Orders, nil
+}
+
+// ListOrderReturns returns all returns on an order, summary fields only.
+func (c *Client) ListOrderReturns(orderID string) ([]Return, error) {
+	gql := `
+		query ListOrderReturns($id: ID!, $after: String) {
+			order(id: $id) {
+				returns(first: 50, after: $after) {
+					edges { node {` + returnSummaryFields + `} }
+					pageInfo { hasNextPage endCursor }
+				}
+			}
+		}`
+	var returns []Return
+	after := ""
+	for {
+		vars := map[string]any{"id": ToGID("Order", orderID)}
+		if after != "" {
+			vars["after"] = after
+		}
+		resp, err := c.Do(gql, vars)
+		if err != nil {
+			return nil, err
+		}
+		var data struct {
+			Order *struct {
+				Returns ReturnConnection `json:"returns"`
+			} `json:"order"`
+		}
+		if err := json.Unmarshal(resp.Data, &data); err != nil {
+			return nil, fmt.Errorf("parsing returns: %w", err)
+		}
+		if data.Order == nil {
+			return nil, fmt.Errorf("order %s not found", orderID)
+		}
+		for _, e := range data.Order.Returns.Edges {
+			returns = append(returns, e.Node)
+		}
+		if !data.Order.Returns.PageInfo.HasNextPage {
+			return returns, nil
+		}
+		after = data.Order.Returns.PageInfo.EndCursor
+	}
+}
+
+// GetReturn returns a single return with its line items, reverse fulfillment
+// orders, and refunds. Line items beyond the first page are fetched with follow-up
+// queries; a return with more than 10 refunds or 5 reverse fulfillment orders is
+// an error.
+func (c *Client) GetReturn(id string) (*Return, error) {
+	gql := `
+		query GetReturn($id: ID!) {
+			return(id: $id) {` + returnSummaryFields + `
+				decline { reason note }
+				returnLineItems(first: 25) {
+					edges { node {` + returnLineItemFields + `} }
+					pageInfo { hasNextPage endCursor }
+				}
+				reverseFulfillmentOrders(first: 5) {
+					edges { node { id status } }
+					pageInfo { hasNextPage }
+				}
+				refunds(first: 10) {
+					edges {
+						node {
+							id createdAt note
+							totalRefundedSet { shopMoney { amount currencyCode } }
+						}
+					}
+					pageInfo { hasNextPage }
+				}
+			}
+		}`
+	resp, err := c.Do(gql, map[string]any{"id": ToGID("Return", id)})
+	if err != nil {
+		return nil, err
+	}
+	var data struct {
+		Return *Return `json:"return"`
+	}
+	if err := json.Unmarshal(resp.Data, &data); err != nil {
+		return nil, fmt.Errorf("parsing return: %w", err)
+	}
+	r := data.Return
+	if r == nil {
+		return nil, fmt.Errorf("return %s not found", id)
+	}
+	if r.ReverseFulfillmentOrders.PageInfo.HasNextPage || r.Refunds.PageInfo.HasNextPage {
+		return nil, fmt.Errorf("return %s has more than 5 reverse fulfillment orders or 10 refunds, which isn't supported", r.Name)
+	}
+	for r.ReturnLineItems.PageInfo.HasNextPage {
+		conn, err := c.returnLineItems(r.ID, r.ReturnLineItems.PageInfo.EndCursor)
+		if err != nil {
+			return nil, err
+		}
+		r.ReturnLineItems.Edges = append(r.ReturnLineItems.Edges, conn.Edges...)
+		r.ReturnLineItems.PageInfo = conn.PageInfo
+	}
+	for i := range r.ReverseFulfillmentOrders.Edges {
+		rfo := &r.ReverseFulfillmentOrders.Edges[i].Node
+		after := ""
+		for {
+			conn, err := c.reverseFulfillmentOrderLineItems(rfo.ID, after)
+			if err != nil {
+				return nil, err
+			}
+			rfo.LineItems.Edges = append(rfo.LineItems.Edges, conn.Edges...)
+			if !conn.PageInfo.HasNextPage {
+				break
+			}
+			after = conn.PageInfo.EndCursor
+		}
+	}
+	return r, nil
+}
+
+// returnLineItems returns the next page of a return's line items.
+func (c *Client) returnLineItems(returnID, after string) (*ReturnLineItemConnection, error) {
+	gql := `
+		query ReturnLineItems($id: ID!, $after: String) {
+			return(id: $id) {
+				returnLineItems(first: 25, after: $after) {
+					edges { node {` + returnLineItemFields + `} }
+					pageInfo { hasNextPage endCursor }
+				}
+			}
+		}`
+	resp, err := c.Do(gql, map[string]any{"id": returnID, "after": after})
+	if err != nil {
+		return nil, err
+	}
+	var data struct {
+		Return *struct {
+			ReturnLineItems ReturnLineItemConnection `json:"returnLineItems"`
+		} `json:"return"`
+	}
+	if err := json.Unmarshal(resp.Data, &data); err != nil {
+		return nil, fmt.Errorf("parsing return line items: %w", err)
+	}
+	if data.Return == nil {
+		return nil, fmt.Errorf("return %s not found", returnID)
+	}
+	return &data.Return.ReturnLineItems, nil
+}
+
+// reverseFulfillmentOrderLineItems returns a page of a reverse fulfillment order's
+// line items with their dispositions.
+func (c *Client) reverseFulfillmentOrderLineItems(id, after string) (*ReverseFulfillmentOrderLineItemConnection, error) {
+	gql := `
+		query ReverseFulfillmentOrderLineItems($id: ID!, $after: String) {
+			reverseFulfillmentOrder(id: $id) {
+				lineItems(first: 25, after: $after) {
+					edges { node {` + reverseFulfillmentOrderLineItemFields + `} }
+					pageInfo { hasNextPage endCursor }
+				}
+			}
+		}`
+	vars := map[string]any{"id": id}
+	if after != "" {
+		vars["after"] = after
+	}
+	resp, err := c.Do(gql, vars)
+	if err != nil {
+		return nil, err
+	}
+	var data struct {
+		ReverseFulfillmentOrder *struct {
+			LineItems ReverseFulfillmentOrderLineItemConnection `json:"lineItems"`
+		} `json:"reverseFulfillmentOrder"`
+	}
+	if err := json.Unmarshal(resp.Data, &data); err != nil {
+		return nil, fmt.Errorf("parsing reverse fulfillment order: %w", err)
+	}
+	if data.ReverseFulfillmentOrder == nil {
+		return nil, fmt.Errorf("reverse fulfillment order %s not found", id)
+	}
+	return &data.ReverseFulfillmentOrder.LineItems, nil
+}
+
+// returnableLineItems is a page of returnableFulfillmentLineItems.
+type returnableLineItems struct {
+	Edges []struct {
+		Node struct {
+			Quantity            int                 `json:"quantity"`
+			FulfillmentLineItem FulfillmentLineItem `json:"fulfillmentLineItem"`
+		} `json:"node"`
+	} `json:"edges"`
+	PageInfo PageInfo `json:"pageInfo"`
+}
+
+const returnableLineItemFields = `
+	edges {
+		node {
+			quantity
+			fulfillmentLineItem { id lineItem { id title sku } }
+		}
+	}
+	pageInfo { hasNextPage endCursor }`
+
+// ListReturnableLines returns the order name and the fulfilled line item
+// quantities that can still be returned.
+func (c *Client) ListReturnableLines(orderID string) (string, []ReturnableLine, error) {
+	gql := `
+		query ListReturnableLines($id: ID!, $after: String) {
+			order(id: $id) {
+				name
+			}
+			returnableFulfillments(orderId: $id, first: 5, after: $after) {
+				edges {
+					node {
+						id
+						returnableFulfillmentLineItems(first: 25) {` + returnableLineItemFields + `}
+					}
+				}
+				pageInfo { hasNextPage endCursor }
+			}
+		}`
+	var name string
+	var lines []ReturnableLine
+	add := func(items returnableLineItems) {
+		for _, e := range items.Edges {
+			lines = append(lines, ReturnableLine{
+				FulfillmentLineItemID: e.Node.FulfillmentLineItem.ID,
+				Quantity:              e.Node.Quantity,
+				LineItem:              e.Node.FulfillmentLineItem.LineItem,
+			})
+		}
+	}
+	after := ""
+	for {
+		vars := map[string]any{"id": ToGID("Order", orderID)}
+		if after != "" {
+			vars["after"] = after
+		}
+		resp, err := c.Do(gql, vars)
+		if err != nil {
+			return "", nil, err
+		}
+		var data struct {
+			Order *struct {
+				Name string `json:"name"`
+			} `json:"order"`
+			ReturnableFulfillments struct {
+				Edges []struct {
+					Node struct {
+						ID                             string              `json:"id"`
+						ReturnableFulfillmentLineItems returnableLineItems `json:"returnableFulfillmentLineItems"`
+					} `json:"node"`
+				} `json:"edges"`
+				PageInfo PageInfo `json:"pageInfo"`
+			} `json:"returnableFulfillments"`
+		}
+		if err := json.Unmarshal(resp.Data, &data); err != nil {
+			return "", nil, fmt.Errorf("parsing returnable fulfillments: %w", err)
+		}
+		if data.Order == nil {
+			return "", nil, fmt.Errorf("order %s not found", orderID)
+		}
+		name = data.Order.Name
+		for _, f := range data.ReturnableFulfillments.Edges {
+			items := f.Node.ReturnableFulfillmentLineItems
+			add(items)
+			for items.PageInfo.HasNextPage {
+				next, err := c.returnableFulfillmentLineItems(f.Node.ID, items.PageInfo.EndCursor)
+				if err != nil {
+					return "", nil, err
+				}
+				items = *next
+				add(items)
+			}
+		}
+		if !data.ReturnableFulfillments.PageInfo.HasNextPage {
+			return name, lines, nil
+		}
+		after = data.ReturnableFulfillments.PageInfo.EndCursor
+	}
+}
+
+// returnableFulfillmentLineItems returns the next page of a returnable fulfillment's line items.
+func (c *Client) returnableFulfillmentLineItems(id, after string) (*returnableLineItems, error) {
+	gql := `
+		query ReturnableFulfillmentLineItems($id: ID!, $after: String) {
+			returnableFulfillment(id: $id) {
+				returnableFulfillmentLineItems(first: 25, after: $after) {` + returnableLineItemFields + `}
+			}
+		}`
+	resp, err := c.Do(gql, map[string]any{"id": id, "after": after})
+	if err != nil {
+		return nil, err
+	}
+	var data struct {
+		ReturnableFulfillment *struct {
+			ReturnableFulfillmentLineItems returnableLineItems `json:"returnableFulfillmentLineItems"`
+		} `json:"returnableFulfillment"`
+	}
+	if err := json.Unmarshal(resp.Data, &data); err != nil {
+		return nil, fmt.Errorf("parsing returnable fulfillment: %w", err)
+	}
+	if data.ReturnableFulfillment == nil {
+		return nil, fmt.Errorf("returnable fulfillment %s not found", id)
+	}
+	return &data.ReturnableFulfillment.ReturnableFulfillmentLineItems, nil
+}
+
+// RequestReturn creates a return request on an order, pending merchant approval.
+func (c *Client) RequestReturn(orderID string, lines []ReturnRequestLine) (*Return, error) {
+	gql := `
+		mutation returnRequest($input: ReturnRequestInput!) {
+			returnRequest(input: $input) {
+				return { id }
+				userErrors { field message }
+			}
+		}`
+	items := make([]map[string]any, len(lines))
+	for i, l := range lines {
+		item := map[string]any{
+			"fulfillmentLineItemId": ToGID("FulfillmentLineItem", l.FulfillmentLineItemID),
+			"quantity":              l.Quantity,
+			"returnReason":          l.Reason,
+		}
+		if l.CustomerNote != "" {
+			item["customerNote"] = l.CustomerNote
+		}
+		items[i] = item
+	}
+	input := map[string]any{
+		"orderId":         ToGID("Order", orderID),
+		"returnLineItems": items,
+	}
+	resp, err := c.Do(gql, map[string]any{"input": input})
+	if err != nil {
+		return nil, err
+	}
+	var data struct {
+		ReturnRequest struct {
+			Return     *Return     `json:"return"`
+			UserErrors []UserError `json:"userErrors"`
+		} `json:"returnRequest"`
+	}
+	if err := json.Unmarshal(resp.Data, &data); err != nil {
+		return nil, fmt.Errorf("parsing response: %w", err)
+	}
+	if err := userErrorsToError(data.ReturnRequest.UserErrors); err != nil {
+		return nil, err
+	}
+	return c.GetReturn(data.ReturnRequest.Return.ID)
+}
+
+// ApproveReturn approves a requested return, opening it for receipt.
+func (c *Client) ApproveReturn(id string) (*Return, error) {
+	const gql = `
+		mutation returnApproveRequest($input: ReturnApproveRequestInput!) {
+			returnApproveRequest(input: $input) {
+				return { id name status }
+				userErrors { field message }
+			}
+		}`
+	resp, err := c.Do(gql, map[string]any{"input": map[string]any{"id": ToGID("Return", id)}})
+	if err != nil {
+		return nil, err
+	}
+	var data struct {
+		ReturnApproveRequest struct {
+			Return     *Return     `json:"return"`
+			UserErrors []UserError `json:"userErrors"`
+		} `json:"returnApproveRequest"`
+	}
+	if err := json.Unmarshal(resp.Data, &data); err != nil {
+		return nil, fmt.Errorf("parsing response: %w", err)
+	}
+	if err := userErrorsToError(data.ReturnApproveRequest.UserErrors); err != nil {
+		return nil, err
+	}
+	return data.ReturnApproveRequest.Return, nil
+}
+
+// DeclineReturn declines a requested return. Reason is FINAL_SALE,
+// RETURN_PERIOD_ENDED, or OTHER.
+func (c *Client) DeclineReturn(id, reason, note string, notify bool) (*Return, error) {
+	const gql = `
+		mutation returnDeclineRequest($input: ReturnDeclineRequestInput!) {
+			returnDeclineRequest(input: $input) {
+				return { id name status decline { reason note } }
+				userErrors { field message }
+			}
+		}`
+	input := map[string]any{
+		"id":             ToGID("Return", id),
+		"declineReason":  reason,
+		"notifyCustomer": notify,
+	}
+	if note != "" {
+		input["declineNote"] = note
+	}
+	resp, err := c.Do(gql, map[string]any{"input": input})
+	if err != nil {
+		return nil, err
+	}
+	var data struct {
+		ReturnDeclineRequest struct {
+			Return     *Return     `json:"return"`
+			UserErrors []UserError `json:"userErrors"`
+		} `json:"returnDeclineRequest"`
+	}
+	if err := json.Unmarshal(resp.Data, &data); err != nil {
+		return nil, fmt.Errorf("parsing response: %w", err)
+	}
+	if err := userErrorsToError(data.ReturnDeclineRequest.UserErrors); err != nil {
+		return nil, err
+	}
+	return data.ReturnDeclineRequest.Return, nil
+}
+
+// DisposeReverseFulfillment records received units of a return as restocked,
+// not restocked, needing processing, or missing.
+func (c *Client) DisposeReverseFulfillment(inputs []ReverseDispositionInput) error {
+	const gql = `
+		mutation reverseFulfillmentOrderDispose($dispositionInputs: [ReverseFulfillmentOrderDisposeInput!]!) {
+			reverseFulfillmentOrderDispose(dispositionInputs: $dispositionInputs) {
+				reverseFulfillmentOrderLineItems { id }
+				userErrors { field message }
+			}
+		}`
+	vars := make([]map[string]any, len(inputs))
+	for i, in := range inputs {
+		v := map[string]any{
+			"reverseFulfillmentOrderLineItemId": ToGID("ReverseFulfillmentOrderLineItem", in.LineItemID),
+			"quantity":                          in.Quantity,
+			"dispositionType":                   in.Type,
+		}
+		if in.LocationID != "" {
+			v["locationId"] = ToGID("Location", in.LocationID)
+		}
+		vars[i] = v
+	}
+	resp, err := c.Do(gql, map[string]any{"dispositionInputs": vars})
+	if err != nil {
+		return err
+	}
+	var data struct {
+		ReverseFulfillmentOrderDispose struct {
+			UserErrors []UserError `json:"userErrors"`
+		} `json:"reverseFulfillmentOrderDispose"`
+	}
+	if err := json.Unmarshal(resp.Data, &data); err != nil {
+		return fmt.Errorf("parsing response: %w", err)
+	}
+	return userErrorsToError(data.ReverseFulfillmentOrderDispose.UserErrors)
+}
+
+// CloseReturn closes an open return.
+func (c *Client) CloseReturn(id string) (*Return, error) {
+	const gql = `
+		mutation returnClose($id: ID!) {
+			returnClose(id: $id) {
+				return { id name status closedAt }
+				userErrors { field message }
+			}
+		}`
+	resp, err := c.Do(gql, map[string]any{"id": ToGID("Return", id)})
+	if err != nil {
+		return nil, err
+	}
+	var data struct {
+		ReturnClose struct {
+			Return     *Return     `json:"return"`
+			UserErrors []UserError `json:"userErrors"`
+		} `json:"returnClose"`
+	}
+	if err := json.Unmarshal(resp.Data, &data); err != nil {
+		return nil, fmt.Errorf("parsing response: %w", err)
+	}
+	if err := userErrorsToError(data.ReturnClose.UserErrors); err != nil {
+		return nil, err
+	}
+	return data.ReturnClose.Return, nil
+}
@@ -0,0 +1,246 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+const sellingPlanFields = `
+	id name description options category
+	billingPolicy {
+		__typename
+		... on SellingPlanRecurringBillingPolicy { interval intervalCount }
+		... on SellingPlanFixedBillingPolicy {
+			remainingBalanceChargeTrigger
+			checkoutCharge {
+				type
+				value {
+					__typename
+					... on MoneyV2 { amount currencyCode }
+					... on SellingPlanCheckoutChargePercentageValue { percentage }
+				}
+			}
+		}
+	}
+	deliveryPolicy {
+		__typename
+		... on SellingPlanRecurringDeliveryPolicy { interval intervalCount }
+		... on SellingPlanFixedDeliveryPolicy { fulfillmentTrigger fulfillmentExactTime }
+	}
+	pricingPolicies {
+		__typename
+		... on SellingPlanFixedPricingPolicy {
+			adjustmentType
+			adjustmentValue {
+				__typename
+				... on MoneyV2 { amount currencyCode }
+				... on SellingPlanPricingPolicyPercentageValue { percentage }
+			}
+		}
+		... on SellingPlanRecurringPricingPolicy {
+			afterCycle adjustmentType
+			adjustmentValue {
+				__typename
+				... on MoneyV2 { amount currencyCode }
+				... on SellingPlanPricingPolicyPercentageValue { percentage }
+			}
+		}
+	}`
+
+// ListSellingPlanGroups returns a paginated list of selling plan groups.
+func (c *Client) ListSellingPlanGroups(first int, after, query string) (*SellingPlanGroupConnection, error) {
+	const gql = `
+		query ListSellingPlanGroups($first: Int!, $after: String, $query: String) {
+			sellingPlanGroups(first: $first, after: $after, query: $query) {
+				edges {
+					cursor
+					node {
+						id name merchantCode summary createdAt
+						productsCount { count }
+					}
+				}
+				pageInfo { hasNextPage endCursor }
+			}
+		}`
+	vars := map[string]any{"first": first}
+	if after != "" {
+		vars["after"] = after
+	}
+	if query != "" {
+		vars["query"] = query
+	}
+	resp, err := c.Do(gql, vars)
+	if err != nil {
+		return nil, err
+	}
+	var data struct {
+		SellingPlanGroups SellingPlanGroupConnection `json:"sellingPlanGroups"`
+	}
+	if err := json.Unmarshal(resp.Data, &data); err != nil {
+		return nil, fmt.Errorf("parsing selling plan groups: %w", err)
+	}
+	return &data.SellingPlanGroups, nil
+}
+
+// GetSellingPlanGroup returns a selling plan group with its plans and products.
+func (c *Client) GetSellingPlanGroup(id string) (*SellingPlanGroup, error) {
+	gql := `
+		query GetSellingPlanGroup($id: ID!) {
+			sellingPlanGroup(id: $id) {
+				id name merchantCode description summary options createdAt
+				productsCount { count }
+				sellingPlans(first: 50) {
+					edges { node {` + sellingPlanFields + `} }
+				}
+				products(first: 50) {
+					edges { node { id title } }
+					pageInfo { hasNextPage endCursor }
+				}
+			}
+		}`
+	resp, err := c.Do(gql, map[string]any{"id": ToGID("SellingPlanGroup", id)})
+	if err != nil {
+		return nil, err
+	}
+	var data struct {
+		SellingPlanGroup *SellingPlanGroup `json:"sellingPlanGroup"`
+	}
+	if err := json.Unmarshal(resp.Data, &data); err != nil {
+		return nil, fmt.Errorf("parsing selling plan group: %w", err)
+	}
+	if data.SellingPlanGroup == nil {
+		return nil, fmt.Errorf("selling plan group %s not found", id)
+	}
+	return data.SellingPlanGroup, nil
+}
+
+// CreateSellingPlanGroup creates a selling plan group from a SellingPlanGroupInput
+// and optionally attaches products to it.
+func (c *Client) CreateSellingPlanGroup(input map[string]any, productIDs []string) (*SellingPlanGroup, error) {
+	const gql = `
+		mutation sellingPlanGroupCreate($input: SellingPlanGroupInput!, $resources: SellingPlanGroupResourceInput) {
+			sellingPlanGroupCreate(input: $input, resources: $resources) {
+				sellingPlanGroup {
+					id name merchantCode summary
+					productsCount { count }
+				}
+				userErrors { field message }
+			}
+		}`
+	vars := map[string]any{"input": input}
+	if len(productIDs) > 0 {
+		gids := make([]string, len(productIDs))
+		for i, id := range productIDs {
+			gids[i] = ToGID("Product", id)
+		}
+		vars["resources"] = map[string]any{"productIds": gids}
+	}
+	resp, err := c.Do(gql, vars)
+	if err != nil {
+		return nil, err
+	}
+	var data struct {
+		SellingPlanGroupCreate struct {
+			SellingPlanGroup *SellingPlanGroup `json:"sellingPlanGroup"`
+			UserErrors       []UserError       `json:"userErrors"`
+		} `json:"sellingPlanGroupCreate"`
+	}
+	if err := json.Unmarshal(resp.Data, &data); err != nil {
+		return nil, fmt.Errorf("parsing response: %w", err)
+	}
+	if err := userErrorsToError(data.SellingPlanGroupCreate.UserErrors); err != nil {
+		return nil, err
+	}
+	return data.SellingPlanGroupCreate.SellingPlanGroup, nil
+}
+
+// UpdateSellingPlanGroup updates a selling plan group from a SellingPlanGroupInput.
+func (c *Client) UpdateSellingPlanGroup(id string, input map[string]any) (*SellingPlanGroup, error) {
+	const gql = `
+		mutation sellingPlanGroupUpdate($id: ID!, $input: SellingPlanGroupInput!) {
+			sellingPlanGroupUpdate(id: $id, input: $input) {
+				sellingPlanGroup {
+					id name merchantCode summary
+					productsCount { count }
+				}
+				userErrors { field message }
+			}
+		}`
+	resp, err := c.Do(gql, map[string]any{"id": ToGID("SellingPlanGroup", id), "input": input})
+	if err != nil {
+		return nil, err
+	}
+	var data struct {
+		SellingPlanGroupUpdate struct {
+			SellingPlanGroup *SellingPlanGroup `json:"sellingPlanGroup"`
+			UserErrors       []UserError       `json:"userErrors"`
+		} `json:"sellingPlanGroupUpdate"`
+	}
+	if err := json.Unmarshal(resp.Data, &data); err != nil {
+		return nil, fmt.Errorf("parsing response: %w", err)
+	}
+	if err := userErrorsToError(data.SellingPlanGroupUpdate.UserErrors); err != nil {
+		return nil, err
+	}
+	return data.SellingPlanGroupUpdate.SellingPlanGroup, nil
+}
+
+// DeleteSellingPlanGroup deletes a selling plan group.
+func (c *Client) DeleteSellingPlanGroup(id string) error {
+	const gql = `
+		mutation sellingPlanGroupDelete($id: ID!) {
+			sellingPlanGroupDelete(id: $id) {
+				deletedSellingPlanGroupId
+				userErrors { field message }
+			}
+		}`
+	resp, err := c.Do(gql, map[string]any{"id": ToGID("SellingPlanGroup", id)})
+	if err != nil {
+		return err
+	}
+	var data struct {
+		SellingPlanGroupDelete struct {
+			DeletedSellingPlanGroupId string      `json:"deletedSellingPlanGroupId"`
+			UserErrors                []UserError `json:"userErrors"`
+		} `json:"sellingPlanGroupDelete"`
+	}
+	if err := json.Unmarshal(resp.Data, &data); err != nil {
+		return fmt.Errorf("parsing response: %w", err)
+	}
+	return userErrorsToError(data.SellingPlanGroupDelete.UserErrors)
+}
+
+// AddProductsToSellingPlanGroup attaches products to a selling plan group.
+func (c *Client) AddProductsToSellingPlanGroup(id string, productIDs []string) (*SellingPlanGroup, error) {
+	const gql = `
+		mutation sellingPlanGroupAddProducts($id: ID!, $productIds: [ID!]!) {
+			sellingPlanGroupAddProducts(id: $id, productIds: $productIds) {
+				sellingPlanGroup {
+					id name
+					productsCount { count }
+				}
+				userErrors { field message }
+			}
+		}`
+	gids := make([]string, len(productIDs))
+	for i, pid := range productIDs {
+		gids[i] = ToGID("Product", pid)
+	}
+	resp, err := c.Do(gql, map[string]any{"id": ToGID("SellingPlanGroup", id), "productIds": gids})
+	if err != nil {
+		return nil, err
+	}
+	var data struct {
+		SellingPlanGroupAddProducts struct {
+			SellingPlanGroup *SellingPlanGroup `json:"sellingPlanGroup"`
+			UserErrors       []UserError       `json:"userErrors"`
+		} `json:"sellingPlanGroupAddProducts"`
+	}
+	if err := json.Unmarshal(resp.Data, &data); err != nil {
+		return nil, fmt.Errorf("parsing response: %w", err)
+	}
+	if err := userErrorsToError(data.SellingPlanGroupAddProducts.UserErrors); err != nil {
+		return nil, err
+	}
+	return data.SellingPlanGroupAddProducts.SellingPlanGroup, nil
+}
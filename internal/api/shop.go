@@ -19,6 +19,7 @@ func (c *Client) GetShop() (*Shop, error) {
 			timezoneAbbreviation
 			createdAt
 			plan { displayName shopifyPlus }
+			billingAddress { company address1 address2 city province zip country phone }
 		}
 	}`
 	resp, err := c.Do(query, nil)
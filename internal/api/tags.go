@@ -0,0 +1,114 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// TaggableResource describes a resource type that supports tagsAdd/tagsRemove.
+type TaggableResource struct {
+	Name       string // CLI name, e.g. "draft-orders"
+	Connection string // GraphQL connection field, e.g. "draftOrders"
+	GIDType    string // GID resource type, e.g. "DraftOrder"
+}
+
+var taggableResources = []TaggableResource{
+	{Name: "products", Connection: "products", GIDType: "Product"},
+	{Name: "orders", Connection: "orders", GIDType: "Order"},
+	{Name: "customers", Connection: "customers", GIDType: "Customer"},
+	{Name: "draft-orders", Connection: "draftOrders", GIDType: "DraftOrder"},
+}
+
+// LookupTaggableResource returns the taggable resource matching name.
+// Singular forms ("product") are accepted as well.
+func LookupTaggableResource(name string) (TaggableResource, error) {
+	key := strings.ToLower(strings.TrimSpace(name))
+	names := make([]string, len(taggableResources))
+	for i, r := range taggableResources {
+		if key == r.Name || key+"s" == r.Name {
+			return r, nil
+		}
+		names[i] = r.Name
+	}
+	return TaggableResource{}, fmt.Errorf("unknown resource %q — available: %s", name, strings.Join(names, ", "))
+}
+
+// ListTagged returns a page of resources with their tags, optionally filtered by a search query.
+func (c *Client) ListTagged(resource TaggableResource, first int, after, query string) (*TaggedNodeConnection, error) {
+	gql := fmt.Sprintf(`
+		query ListTagged($first: Int!, $after: String, $query: String) {
+			%s(first: $first, after: $after, query: $query) {
+				edges {
+					cursor
+					node { id tags }
+				}
+				pageInfo { hasNextPage endCursor }
+			}
+		}`, resource.Connection)
+	vars := map[string]any{"first": first}
+	if after != "" {
+		vars["after"] = after
+	}
+	if query != "" {
+		vars["query"] = query
+	}
+	resp, err := c.Do(gql, vars)
+	if err != nil {
+		return nil, err
+	}
+	var data map[string]TaggedNodeConnection
+	if err := json.Unmarshal(resp.Data, &data); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", resource.Name, err)
+	}
+	conn := data[resource.Connection]
+	return &conn, nil
+}
+
+// AddTags adds tags to a resource without touching its existing tags.
+func (c *Client) AddTags(resource TaggableResource, id string, tags []string) error {
+	const gql = `
+		mutation tagsAdd($id: ID!, $tags: [String!]!) {
+			tagsAdd(id: $id, tags: $tags) {
+				node { id }
+				userErrors { field message }
+			}
+		}`
+	resp, err := c.Do(gql, map[string]any{"id": ToGID(resource.GIDType, id), "tags": tags})
+	if err != nil {
+		return err
+	}
+	var data struct {
+		TagsAdd struct {
+			UserErrors []UserError `json:"userErrors"`
+		} `json:"tagsAdd"`
+	}
+	if err := json.Unmarshal(resp.Data, &data); err != nil {
+		return fmt.Errorf("parsing response: %w", err)
+	}
+	return userErrorsToError(data.TagsAdd.UserErrors)
+}
+
+// RemoveTags removes tags from a resource, leaving its other tags in place.
+func (c *Client) RemoveTags(resource TaggableResource, id string, tags []string) error {
+	const gql = `
+		mutation tagsRemove($id: ID!, $tags: [String!]!) {
+			tagsRemove(id: $id, tags: $tags) {
+				node { id }
+				userErrors { field message }
+			}
+		}`
+	resp, err := c.Do(gql, map[string]any{"id": ToGID(resource.GIDType, id), "tags": tags})
+	if err != nil {
+		return err
+	}
+	var data struct {
+		TagsRemove struct {
+			UserErrors []UserError `json:"userErrors"`
+		} `json:"tagsRemove"`
+	}
+	if err := json.Unmarshal(resp.Data, &data); err != nil {
+		return fmt.Errorf("parsing response: %w", err)
+	}
+	return userErrorsToError(data.TagsRemove.UserErrors)
+}
@@ -0,0 +1,118 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+const orderTransactionFields = `
+	id kind status gateway createdAt errorCode authorizationExpiresAt
+	amountSet { shopMoney { amount currencyCode } presentmentMoney { amount currencyCode } }
+	totalUnsettledSet { shopMoney { amount currencyCode } presentmentMoney { amount currencyCode } }
+	parentTransaction { id }`
+
+// ListOrderTransactions returns an order with its payment transactions and the
+// amount that can still be captured.
+func (c *Client) ListOrderTransactions(orderID string) (*Order, error) {
+	gql := `
+		query ListOrderTransactions($id: ID!) {
+			order(id: $id) {
+				id name financialStatus
+				totalPriceSet { shopMoney { amount currencyCode } }
+				totalCapturableSet { shopMoney { amount currencyCode } presentmentMoney { amount currencyCode } }
+				transactions(first: 100) {` + orderTransactionFields + `}
+			}
+		}`
+	resp, err := c.Do(gql, map[string]any{"id": ToGID("Order", orderID)})
+	if err != nil {
+		return nil, err
+	}
+	var data struct {
+		Order *Order `json:"order"`
+	}
+	if err := json.Unmarshal(resp.Data, &data); err != nil {
+		return nil, fmt.Errorf("parsing transactions: %w", err)
+	}
+	if data.Order == nil {
+		return nil, fmt.Errorf("order %s not found", orderID)
+	}
+	return data.Order, nil
+}
+
+// ListAuthorizedOrders returns a paginated list of orders with their capturable
+// authorizations (up to 5 per order). The query is usually "financial_status:authorized".
+// Each order costs about 50 points, so keep first at 10 or less.
+func (c *Client) ListAuthorizedOrders(first int, after, query string) (*OrderConnection, error) {
+	gql := `
+		query ListAuthorizedOrders($first: Int!, $after: String, $query: String) {
+			orders(first: $first, after: $after, query: $query) {
+				edges {
+					cursor
+					node {
+						id name financialStatus displayFulfillmentStatus createdAt
+						totalPriceSet { shopMoney { amount currencyCode } }
+						totalCapturableSet { shopMoney { amount currencyCode } }
+						customer { firstName lastName }
+						transactions(first: 5, capturable: true) {` + orderTransactionFields + `}
+					}
+				}
+				pageInfo { hasNextPage endCursor }
+			}
+		}`
+	vars := map[string]any{"first": first}
+	if after != "" {
+		vars["after"] = after
+	}
+	if query != "" {
+		vars["query"] = query
+	}
+	resp, err := c.Do(gql, vars)
+	if err != nil {
+		return nil, err
+	}
+	var data struct {
+		Orders OrderConnection `json:"orders"`
+	}
+	if err := json.Unmarshal(resp.Data, &data); err != nil {
+		return nil, fmt.Errorf("parsing orders: %w", err)
+	}
+	return &data.Orders, nil
+}
+
+// CaptureOrder captures amount (in the presentment currency) against an authorization
+// transaction. Capturing less than the authorized amount leaves the rest capturable
+// unless the gateway only supports a single capture.
+func (c *Client) CaptureOrder(orderID, parentTransactionID, amount, currency string) (*OrderTransaction, error) {
+	gql := `
+		mutation orderCapture($input: OrderCaptureInput!) {
+			orderCapture(input: $input) {
+				transaction {` + orderTransactionFields + `}
+				userErrors { field message }
+			}
+		}`
+	input := map[string]any{
+		"id":                  ToGID("Order", orderID),
+		"parentTransactionId": ToGID("OrderTransaction", parentTransactionID),
+		"amount":              amount,
+	}
+	if currency != "" {
+		input["currency"] = currency
+	}
+	resp, err := c.Do(gql, map[string]any{"input": input})
+	if err != nil {
+		return nil, err
+	}
+	var data struct {
+		OrderCapture struct {
+			Transaction *OrderTransaction `json:"transaction"`
+			UserErrors  []UserError       `json:"userErrors"`
+		} `json:"orderCapture"`
+	}
+	if err := json.Unmarshal(resp.Data, &data); err != nil {
+		return nil, fmt.Errorf("parsing response: %w", err)
+	}
+	if err := userErrorsToError(data.OrderCapture.UserErrors); err != nil {
+		return nil, err
+	}
+	return data.OrderCapture.Transaction, nil
+}
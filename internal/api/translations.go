@@ -0,0 +1,107 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// ListShopLocales returns the locales enabled on the shop.
+func (c *Client) ListShopLocales() ([]ShopLocale, error) {
+	const gql = `{
+		shopLocales { locale name primary published }
+	}`
+	resp, err := c.Do(gql, nil)
+	if err != nil {
+		return nil, err
+	}
+	var data struct {
+		ShopLocales []ShopLocale `json:"shopLocales"`
+	}
+	if err := json.Unmarshal(resp.Data, &data); err != nil {
+		return nil, fmt.Errorf("parsing shop locales: %w", err)
+	}
+	return data.ShopLocales, nil
+}
+
+// ListTranslatableResources returns translatable content and existing translations
+// for one locale. resourceType is a TranslatableResourceType such as PRODUCT or METAFIELD.
+func (c *Client) ListTranslatableResources(resourceType, locale string, first int, after string) (*TranslatableResourceConnection, error) {
+	const gql = `
+		query ListTranslatableResources($first: Int!, $after: String, $resourceType: TranslatableResourceType!, $locale: String!) {
+			translatableResources(first: $first, after: $after, resourceType: $resourceType) {
+				edges {
+					cursor
+					node {
+						resourceId
+						translatableContent { key value digest locale }
+						translations(locale: $locale) { key value locale outdated updatedAt }
+					}
+				}
+				pageInfo { hasNextPage endCursor }
+			}
+		}`
+	vars := map[string]any{
+		"first":        first,
+		"resourceType": resourceType,
+		"locale":       locale,
+	}
+	if after != "" {
+		vars["after"] = after
+	}
+	resp, err := c.Do(gql, vars)
+	if err != nil {
+		return nil, err
+	}
+	var data struct {
+		TranslatableResources TranslatableResourceConnection `json:"translatableResources"`
+	}
+	if err := json.Unmarshal(resp.Data, &data); err != nil {
+		return nil, fmt.Errorf("parsing translatable resources: %w", err)
+	}
+	return &data.TranslatableResources, nil
+}
+
+// TranslationInput is one translation to register for a resource.
+type TranslationInput struct {
+	Key    string
+	Locale string
+	Value  string
+	Digest string // digest of the source content being translated
+}
+
+// RegisterTranslations creates or updates translations for a single resource.
+func (c *Client) RegisterTranslations(resourceID string, translations []TranslationInput) ([]Translation, error) {
+	const gql = `
+		mutation translationsRegister($resourceId: ID!, $translations: [TranslationInput!]!) {
+			translationsRegister(resourceId: $resourceId, translations: $translations) {
+				translations { key value locale outdated updatedAt }
+				userErrors { field message }
+			}
+		}`
+	inputs := make([]map[string]any, len(translations))
+	for i, t := range translations {
+		inputs[i] = map[string]any{
+			"key":                       t.Key,
+			"locale":                    t.Locale,
+			"value":                     t.Value,
+			"translatableContentDigest": t.Digest,
+		}
+	}
+	resp, err := c.Do(gql, map[string]any{"resourceId": resourceID, "translations": inputs})
+	if err != nil {
+		return nil, err
+	}
+	var data struct {
+		TranslationsRegister struct {
+			Translations []Translation `json:"translations"`
+			UserErrors   []UserError   `json:"userErrors"`
+		} `json:"translationsRegister"`
+	}
+	if err := json.Unmarshal(resp.Data, &data); err != nil {
+		return nil, fmt.Errorf("parsing response: %w", err)
+	}
+	if err := userErrorsToError(data.TranslationsRegister.UserErrors); err != nil {
+		return nil, err
+	}
+	return data.TranslationsRegister.Translations, nil
+}
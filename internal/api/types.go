@@ -23,8 +23,9 @@ type GraphQLRequest struct {
 }
 
 type GraphQLResponse struct {
-	Data   json.RawMessage `json:"data"`
-	Errors []GraphQLError  `json:"errors,omitempty"`
+	Data       json.RawMessage    `json:"data"`
+	Errors     []GraphQLError     `json:"errors,omitempty"`
+	Extensions *GraphQLExtensions `json:"extensions,omitempty"`
 }
 
 type GraphQLError struct {
@@ -33,7 +34,23 @@ type GraphQLError struct {
 		Line   int `json:"line"`
 		Column int `json:"column"`
 	} `json:"locations,omitempty"`
-	Path []any `json:"path,omitempty"`
+	Path       []any `json:"path,omitempty"`
+	Extensions struct {
+		Code string `json:"code"`
+	} `json:"extensions"`
+}
+
+// GraphQLExtensions carries the query cost and rate-limit bucket state Shopify
+// returns with every response.
+type GraphQLExtensions struct {
+	Cost struct {
+		RequestedQueryCost float64 `json:"requestedQueryCost"`
+		ThrottleStatus     struct {
+			MaximumAvailable   float64 `json:"maximumAvailable"`
+			CurrentlyAvailable float64 `json:"currentlyAvailable"`
+			RestoreRate        float64 `json:"restoreRate"`
+		} `json:"throttleStatus"`
+	} `json:"cost"`
 }
 
 // ShopifyError is returned when the API responds with an error.
@@ -61,7 +78,8 @@ type MoneyV2 struct {
 }
 
 type MoneyBag struct {
-	ShopMoney MoneyV2 `json:"shopMoney"`
+	ShopMoney        MoneyV2  `json:"shopMoney"`
+	PresentmentMoney *MoneyV2 `json:"presentmentMoney,omitempty"`
 }
 
 type UserError struct {
@@ -84,16 +102,28 @@ type MailingAddress struct {
 // ---- Shop ----
 
 type Shop struct {
-	ID                   string   `json:"id"`
-	Name                 string   `json:"name"`
-	Email                string   `json:"email"`
-	MyshopifyDomain      string   `json:"myshopifyDomain"`
-	PrimaryDomain        Domain   `json:"primaryDomain"`
-	CurrencyCode         string   `json:"currencyCode"`
-	CountryCode          string   `json:"countryCode"`
-	TimezoneAbbreviation string   `json:"timezoneAbbreviation"`
-	CreatedAt            string   `json:"createdAt"`
-	Plan                 ShopPlan `json:"plan"`
+	ID                   string       `json:"id"`
+	Name                 string       `json:"name"`
+	Email                string       `json:"email"`
+	MyshopifyDomain      string       `json:"myshopifyDomain"`
+	PrimaryDomain        Domain       `json:"primaryDomain"`
+	CurrencyCode         string       `json:"currencyCode"`
+	CountryCode          string       `json:"countryCode"`
+	TimezoneAbbreviation string       `json:"timezoneAbbreviation"`
+	CreatedAt            string       `json:"createdAt"`
+	Plan                 ShopPlan     `json:"plan"`
+	BillingAddress       *ShopAddress `json:"billingAddress,omitempty"`
+}
+
+type ShopAddress struct {
+	Company  string `json:"company"`
+	Address1 string `json:"address1"`
+	Address2 string `json:"address2"`
+	City     string `json:"city"`
+	Province string `json:"province"`
+	Zip      string `json:"zip"`
+	Country  string `json:"country"`
+	Phone    string `json:"phone"`
 }
 
 type Domain struct {
@@ -109,18 +139,19 @@ type ShopPlan struct {
 // ---- Products ----
 
 type Product struct {
-	ID             string            `json:"id"`
-	Title          string            `json:"title"`
-	Status         string            `json:"status"`
-	Handle         string            `json:"handle"`
-	Description    string            `json:"description"`
-	TotalInventory int               `json:"totalInventory"`
-	Vendor         string            `json:"vendor"`
-	ProductType    string            `json:"productType"`
-	Tags           []string          `json:"tags"`
-	CreatedAt      string            `json:"createdAt"`
-	UpdatedAt      string            `json:"updatedAt"`
-	Variants       VariantConnection `json:"variants"`
+	ID                string                     `json:"id"`
+	Title             string                     `json:"title"`
+	Status            string                     `json:"status"`
+	Handle            string                     `json:"handle"`
+	Description       string                     `json:"description"`
+	TotalInventory    int                        `json:"totalInventory"`
+	Vendor            string                     `json:"vendor"`
+	ProductType       string                     `json:"productType"`
+	Tags              []string                   `json:"tags"`
+	CreatedAt         string                     `json:"createdAt"`
+	UpdatedAt         string                     `json:"updatedAt"`
+	Variants          VariantConnection          `json:"variants"`
+	SellingPlanGroups SellingPlanGroupConnection `json:"sellingPlanGroups"`
 }
 
 type ProductEdge struct {
@@ -194,15 +225,51 @@ type Order struct {
 	TotalPriceSet            MoneyBag           `json:"totalPriceSet"`
 	SubtotalPriceSet         MoneyBag           `json:"subtotalPriceSet"`
 	TotalTaxSet              MoneyBag           `json:"totalTaxSet"`
+	TotalShippingPriceSet    MoneyBag           `json:"totalShippingPriceSet"`
+	TotalDiscountsSet        MoneyBag           `json:"totalDiscountsSet"`
 	CreatedAt                string             `json:"createdAt"`
 	ProcessedAt              string             `json:"processedAt"`
 	Note                     string             `json:"note"`
 	Tags                     []string           `json:"tags"`
 	Customer                 *OrderCustomer     `json:"customer"`
 	ShippingAddress          *MailingAddress    `json:"shippingAddress"`
+	BillingAddress           *MailingAddress    `json:"billingAddress,omitempty"`
+	CustomAttributes         []Attribute        `json:"customAttributes"`
+	TotalCapturableSet       *MoneyBag          `json:"totalCapturableSet,omitempty"`
+	Transactions             []OrderTransaction `json:"transactions,omitempty"`
+	Risk                     *OrderRisk         `json:"risk,omitempty"`
+	Returns                  *ReturnConnection  `json:"returns,omitempty"`
 	LineItems                LineItemConnection `json:"lineItems"`
 }
 
+type Attribute struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// OrderRisk summarizes fraud analysis. Recommendation is ACCEPT, INVESTIGATE, CANCEL, or NONE.
+type OrderRisk struct {
+	Recommendation string           `json:"recommendation"`
+	Assessments    []RiskAssessment `json:"assessments"`
+}
+
+// RiskAssessment is one provider's verdict. RiskLevel is HIGH, MEDIUM, LOW, NONE, or PENDING.
+type RiskAssessment struct {
+	RiskLevel string        `json:"riskLevel"`
+	Provider  *RiskProvider `json:"provider"`
+	Facts     []RiskFact    `json:"facts"`
+}
+
+// RiskFact is a signal behind an assessment. Sentiment is POSITIVE, NEUTRAL, or NEGATIVE.
+type RiskFact struct {
+	Description string `json:"description"`
+	Sentiment   string `json:"sentiment"`
+}
+
+type RiskProvider struct {
+	Title string `json:"title"`
+}
+
 type OrderCustomer struct {
 	ID        string `json:"id"`
 	FirstName string `json:"firstName"`
@@ -214,8 +281,18 @@ type LineItem struct {
 	ID                   string   `json:"id"`
 	Title                string   `json:"title"`
 	Quantity             int      `json:"quantity"`
+	RefundableQuantity   int      `json:"refundableQuantity"`
+	UnfulfilledQuantity  int      `json:"unfulfilledQuantity"`
 	SKU                  string   `json:"sku"`
+	VariantTitle         string   `json:"variantTitle"`
+	Image                *Image   `json:"image,omitempty"`
 	OriginalUnitPriceSet MoneyBag `json:"originalUnitPriceSet"`
+	DiscountedTotalSet   MoneyBag `json:"discountedTotalSet"`
+}
+
+type Image struct {
+	URL     string `json:"url"`
+	AltText string `json:"altText"`
 }
 
 type LineItemEdge struct {
@@ -253,6 +330,14 @@ type Customer struct {
 	CreatedAt      string          `json:"createdAt"`
 	UpdatedAt      string          `json:"updatedAt"`
 	DefaultAddress *MailingAddress `json:"defaultAddress"`
+	LastOrder      *CustomerOrder  `json:"lastOrder,omitempty"`
+}
+
+// CustomerOrder is the summary of an order placed by a customer.
+type CustomerOrder struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	CreatedAt string `json:"createdAt"`
 }
 
 type CustomerEdge struct {
@@ -329,6 +414,13 @@ type InventoryLevelConnection struct {
 	PageInfo PageInfo             `json:"pageInfo"`
 }
 
+// InventoryChange is one quantity change made by an inventory mutation.
+type InventoryChange struct {
+	Name                string `json:"name"`
+	Delta               int    `json:"delta"`
+	QuantityAfterChange int    `json:"quantityAfterChange"`
+}
+
 // ---- Metafields ----
 
 type Metafield struct {
@@ -454,10 +546,35 @@ type FulfillmentOrder struct {
 	FulfillAt        string                             `json:"fulfillAt"`
 	Destination      *FulfillmentDestination            `json:"destination"`
 	AssignedLocation FulfillmentOrderLocation           `json:"assignedLocation"`
+	FulfillmentHolds []FulfillmentHold                  `json:"fulfillmentHolds"`
 	LineItems        FulfillmentOrderLineItemConnection `json:"lineItems"`
 	Order            *FulfillmentOrderRef               `json:"order"`
 }
 
+// FulfillmentHold is a reason a fulfillment order can't be fulfilled yet, e.g.
+// AWAITING_PAYMENT, HIGH_RISK_OF_FRAUD, INCORRECT_ADDRESS, INVENTORY_OUT_OF_STOCK.
+type FulfillmentHold struct {
+	ID          string `json:"id"`
+	Reason      string `json:"reason"`
+	ReasonNotes string `json:"reasonNotes"`
+}
+
+// FulfillmentOrderHoldResult is the outcome of a hold. FulfillmentOrder is the order
+// now on hold; when only some line items were held, the rest move to
+// RemainingFulfillmentOrder, which can still be fulfilled.
+type FulfillmentOrderHoldResult struct {
+	FulfillmentOrder          *FulfillmentOrder `json:"fulfillmentOrder"`
+	RemainingFulfillmentOrder *FulfillmentOrder `json:"remainingFulfillmentOrder"`
+}
+
+// FulfillmentOrderMoveLocation is a location a fulfillment order could be moved to.
+// Message explains why Movable is false.
+type FulfillmentOrderMoveLocation struct {
+	Location LocationRef `json:"location"`
+	Movable  bool        `json:"movable"`
+	Message  string      `json:"message"`
+}
+
 type FulfillmentOrderRef struct {
 	ID   string `json:"id"`
 	Name string `json:"name"`
@@ -473,7 +590,8 @@ type FulfillmentDestination struct {
 }
 
 type FulfillmentOrderLocation struct {
-	Name string `json:"name"`
+	Name     string       `json:"name"`
+	Location *LocationRef `json:"location,omitempty"`
 }
 
 type FulfillmentOrderLineItem struct {
@@ -510,13 +628,50 @@ type FulfillmentOrderConnection struct {
 }
 
 type Fulfillment struct {
-	ID              string   `json:"id"`
-	Status          string   `json:"status"`
-	CreatedAt       string   `json:"createdAt"`
-	UpdatedAt       string   `json:"updatedAt"`
-	TrackingCompany string   `json:"trackingCompany"`
-	TrackingNumbers []string `json:"trackingNumbers"`
-	TrackingURLs    []string `json:"trackingUrls"`
+	ID                   string                         `json:"id"`
+	Name                 string                         `json:"name,omitempty"`
+	Status               string                         `json:"status"`
+	DisplayStatus        string                         `json:"displayStatus,omitempty"`
+	CreatedAt            string                         `json:"createdAt"`
+	UpdatedAt            string                         `json:"updatedAt"`
+	EstimatedDeliveryAt  *string                        `json:"estimatedDeliveryAt,omitempty"`
+	DeliveredAt          *string                        `json:"deliveredAt,omitempty"`
+	TrackingCompany      string                         `json:"trackingCompany"`
+	TrackingNumbers      []string                       `json:"trackingNumbers"`
+	TrackingURLs         []string                       `json:"trackingUrls"`
+	Order                *FulfillmentOrderRef           `json:"order,omitempty"`
+	Location             *LocationRef                   `json:"location,omitempty"`
+	FulfillmentLineItems *FulfillmentLineItemConnection `json:"fulfillmentLineItems,omitempty"`
+	Events               *FulfillmentEventConnection    `json:"events,omitempty"`
+}
+
+// FulfillmentEvent is a carrier tracking update. Status is e.g. LABEL_PRINTED,
+// IN_TRANSIT, OUT_FOR_DELIVERY, DELIVERED, or FAILURE.
+type FulfillmentEvent struct {
+	ID                  string  `json:"id"`
+	Status              string  `json:"status"`
+	HappenedAt          string  `json:"happenedAt"`
+	Message             string  `json:"message"`
+	City                string  `json:"city"`
+	Province            string  `json:"province"`
+	Country             string  `json:"country"`
+	EstimatedDeliveryAt *string `json:"estimatedDeliveryAt"`
+}
+
+type FulfillmentEventEdge struct {
+	Node FulfillmentEvent `json:"node"`
+}
+
+type FulfillmentEventConnection struct {
+	Edges []FulfillmentEventEdge `json:"edges"`
+}
+
+type FulfillmentLineItemEdge struct {
+	Node FulfillmentLineItem `json:"node"`
+}
+
+type FulfillmentLineItemConnection struct {
+	Edges []FulfillmentLineItemEdge `json:"edges"`
 }
 
 // ---- Markets ----
@@ -571,3 +726,983 @@ type ShopifyQLColumn struct {
 	DataType    string `json:"dataType"`
 	DisplayName string `json:"displayName"`
 }
+
+// ---- Tags ----
+
+type TaggedNode struct {
+	ID   string   `json:"id"`
+	Tags []string `json:"tags"`
+}
+
+type TaggedNodeEdge struct {
+	Node   TaggedNode `json:"node"`
+	Cursor string     `json:"cursor"`
+}
+
+type TaggedNodeConnection struct {
+	Edges    []TaggedNodeEdge `json:"edges"`
+	PageInfo PageInfo         `json:"pageInfo"`
+}
+
+// ---- Translations ----
+
+type ShopLocale struct {
+	Locale    string `json:"locale"`
+	Name      string `json:"name"`
+	Primary   bool   `json:"primary"`
+	Published bool   `json:"published"`
+}
+
+type TranslatableResource struct {
+	ResourceID          string                `json:"resourceId"`
+	TranslatableContent []TranslatableContent `json:"translatableContent"`
+	Translations        []Translation         `json:"translations"`
+}
+
+type TranslatableContent struct {
+	Key    string `json:"key"`
+	Value  string `json:"value"`
+	Digest string `json:"digest"`
+	Locale string `json:"locale"`
+}
+
+type Translation struct {
+	Key       string `json:"key"`
+	Value     string `json:"value"`
+	Locale    string `json:"locale"`
+	Outdated  bool   `json:"outdated"`
+	UpdatedAt string `json:"updatedAt"`
+}
+
+type TranslatableResourceEdge struct {
+	Node   TranslatableResource `json:"node"`
+	Cursor string               `json:"cursor"`
+}
+
+type TranslatableResourceConnection struct {
+	Edges    []TranslatableResourceEdge `json:"edges"`
+	PageInfo PageInfo                   `json:"pageInfo"`
+}
+
+// ---- Selling Plans ----
+
+type SellingPlanGroup struct {
+	ID            string                `json:"id"`
+	Name          string                `json:"name"`
+	MerchantCode  string                `json:"merchantCode"`
+	Description   string                `json:"description"`
+	Summary       string                `json:"summary"`
+	Options       []string              `json:"options"`
+	CreatedAt     string                `json:"createdAt"`
+	ProductsCount *ProductsCount        `json:"productsCount,omitempty"`
+	SellingPlans  SellingPlanConnection `json:"sellingPlans"`
+	Products      ProductRefConnection  `json:"products"`
+}
+
+type SellingPlanGroupEdge struct {
+	Node   SellingPlanGroup `json:"node"`
+	Cursor string           `json:"cursor"`
+}
+
+type SellingPlanGroupConnection struct {
+	Edges    []SellingPlanGroupEdge `json:"edges"`
+	PageInfo PageInfo               `json:"pageInfo"`
+}
+
+type SellingPlan struct {
+	ID              string                     `json:"id"`
+	Name            string                     `json:"name"`
+	Description     string                     `json:"description"`
+	Options         []string                   `json:"options"`
+	Category        string                     `json:"category"`
+	BillingPolicy   SellingPlanPolicy          `json:"billingPolicy"`
+	DeliveryPolicy  SellingPlanPolicy          `json:"deliveryPolicy"`
+	PricingPolicies []SellingPlanPricingPolicy `json:"pricingPolicies"`
+}
+
+// SellingPlanPolicy flattens the recurring and fixed billing/delivery policy unions.
+type SellingPlanPolicy struct {
+	TypeName                      string                     `json:"__typename"`
+	Interval                      string                     `json:"interval,omitempty"`
+	IntervalCount                 int                        `json:"intervalCount,omitempty"`
+	RemainingBalanceChargeTrigger string                     `json:"remainingBalanceChargeTrigger,omitempty"`
+	CheckoutCharge                *SellingPlanCheckoutCharge `json:"checkoutCharge,omitempty"`
+	FulfillmentTrigger            string                     `json:"fulfillmentTrigger,omitempty"`
+	FulfillmentExactTime          string                     `json:"fulfillmentExactTime,omitempty"`
+}
+
+type SellingPlanCheckoutCharge struct {
+	Type  string                `json:"type"`
+	Value SellingPlanPriceValue `json:"value"`
+}
+
+// SellingPlanPricingPolicy flattens the fixed and recurring pricing policy unions.
+type SellingPlanPricingPolicy struct {
+	TypeName        string                `json:"__typename"`
+	AdjustmentType  string                `json:"adjustmentType"`
+	AdjustmentValue SellingPlanPriceValue `json:"adjustmentValue"`
+	AfterCycle      int                   `json:"afterCycle,omitempty"`
+}
+
+// SellingPlanPriceValue is either a MoneyV2 or a percentage value.
+type SellingPlanPriceValue struct {
+	TypeName     string  `json:"__typename"`
+	Amount       string  `json:"amount,omitempty"`
+	CurrencyCode string  `json:"currencyCode,omitempty"`
+	Percentage   float64 `json:"percentage,omitempty"`
+}
+
+type SellingPlanEdge struct {
+	Node   SellingPlan `json:"node"`
+	Cursor string      `json:"cursor"`
+}
+
+type SellingPlanConnection struct {
+	Edges    []SellingPlanEdge `json:"edges"`
+	PageInfo PageInfo          `json:"pageInfo"`
+}
+
+type ProductRef struct {
+	ID    string `json:"id"`
+	Title string `json:"title"`
+}
+
+type ProductRefEdge struct {
+	Node   ProductRef `json:"node"`
+	Cursor string     `json:"cursor"`
+}
+
+type ProductRefConnection struct {
+	Edges    []ProductRefEdge `json:"edges"`
+	PageInfo PageInfo         `json:"pageInfo"`
+}
+
+// ---- Gift Cards ----
+
+type GiftCard struct {
+	ID             string         `json:"id"`
+	LastCharacters string         `json:"lastCharacters"`
+	MaskedCode     string         `json:"maskedCode"`
+	Enabled        bool           `json:"enabled"`
+	InitialValue   MoneyV2        `json:"initialValue"`
+	Balance        MoneyV2        `json:"balance"`
+	ExpiresOn      string         `json:"expiresOn"`
+	DeactivatedAt  string         `json:"deactivatedAt"`
+	Note           string         `json:"note"`
+	CreatedAt      string         `json:"createdAt"`
+	UpdatedAt      string         `json:"updatedAt"`
+	Customer       *OrderCustomer `json:"customer"`
+	Order          *OrderRef      `json:"order"`
+}
+
+type OrderRef struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type GiftCardEdge struct {
+	Node   GiftCard `json:"node"`
+	Cursor string   `json:"cursor"`
+}
+
+type GiftCardConnection struct {
+	Edges    []GiftCardEdge `json:"edges"`
+	PageInfo PageInfo       `json:"pageInfo"`
+}
+
+// ---- Price Lists ----
+
+type PriceList struct {
+	ID               string                   `json:"id"`
+	Name             string                   `json:"name"`
+	Currency         string                   `json:"currency"`
+	FixedPricesCount int                      `json:"fixedPricesCount"`
+	Parent           *PriceListParent         `json:"parent"`
+	Catalog          *CatalogRef              `json:"catalog"`
+	Prices           PriceListPriceConnection `json:"prices"`
+}
+
+// PriceListParent holds the percentage adjustment applied to base prices.
+type PriceListParent struct {
+	Adjustment struct {
+		Type  string  `json:"type"`
+		Value float64 `json:"value"`
+	} `json:"adjustment"`
+}
+
+type CatalogRef struct {
+	ID    string `json:"id"`
+	Title string `json:"title"`
+}
+
+type PriceListPrice struct {
+	OriginType     string   `json:"originType"`
+	Price          MoneyV2  `json:"price"`
+	CompareAtPrice *MoneyV2 `json:"compareAtPrice"`
+	Variant        struct {
+		ID          string `json:"id"`
+		SKU         string `json:"sku"`
+		DisplayName string `json:"displayName"`
+	} `json:"variant"`
+}
+
+type PriceListPriceEdge struct {
+	Node   PriceListPrice `json:"node"`
+	Cursor string         `json:"cursor"`
+}
+
+type PriceListPriceConnection struct {
+	Edges    []PriceListPriceEdge `json:"edges"`
+	PageInfo PageInfo             `json:"pageInfo"`
+}
+
+type PriceListEdge struct {
+	Node   PriceList `json:"node"`
+	Cursor string    `json:"cursor"`
+}
+
+type PriceListConnection struct {
+	Edges    []PriceListEdge `json:"edges"`
+	PageInfo PageInfo        `json:"pageInfo"`
+}
+
+// VariantContextualPrice is a variant's price as seen by a buyer in a given country.
+type VariantContextualPrice struct {
+	ID                string `json:"id"`
+	Title             string `json:"title"`
+	SKU               string `json:"sku"`
+	Price             string `json:"price"`
+	ContextualPricing struct {
+		Price          MoneyV2  `json:"price"`
+		CompareAtPrice *MoneyV2 `json:"compareAtPrice"`
+	} `json:"contextualPricing"`
+}
+
+// ---- Order Edits ----
+
+type CalculatedOrder struct {
+	ID                    string                       `json:"id"`
+	OriginalOrder         CalculatedOrderOriginal      `json:"originalOrder"`
+	SubtotalPriceSet      MoneyBag                     `json:"subtotalPriceSet"`
+	CartDiscountAmountSet MoneyBag                     `json:"cartDiscountAmountSet"`
+	TotalPriceSet         MoneyBag                     `json:"totalPriceSet"`
+	TotalOutstandingSet   MoneyBag                     `json:"totalOutstandingSet"`
+	LineItems             CalculatedLineItemConnection `json:"lineItems"`
+}
+
+type CalculatedOrderOriginal struct {
+	ID                      string   `json:"id"`
+	Name                    string   `json:"name"`
+	PresentmentCurrencyCode string   `json:"presentmentCurrencyCode"`
+	TotalPriceSet           MoneyBag `json:"totalPriceSet"`
+}
+
+type CalculatedLineItem struct {
+	ID                     string   `json:"id"`
+	Title                  string   `json:"title"`
+	SKU                    string   `json:"sku"`
+	Quantity               int      `json:"quantity"`
+	EditableQuantity       int      `json:"editableQuantity"`
+	OriginalUnitPriceSet   MoneyBag `json:"originalUnitPriceSet"`
+	DiscountedUnitPriceSet MoneyBag `json:"discountedUnitPriceSet"`
+	StagedChanges          []struct {
+		TypeName string `json:"__typename"`
+	} `json:"stagedChanges"`
+}
+
+type CalculatedLineItemEdge struct {
+	Node   CalculatedLineItem `json:"node"`
+	Cursor string             `json:"cursor"`
+}
+
+type CalculatedLineItemConnection struct {
+	Edges    []CalculatedLineItemEdge `json:"edges"`
+	PageInfo PageInfo                 `json:"pageInfo"`
+}
+
+// ---- Refunds ----
+
+type Refund struct {
+	ID               string                     `json:"id"`
+	CreatedAt        string                     `json:"createdAt"`
+	Note             string                     `json:"note"`
+	TotalRefundedSet MoneyBag                   `json:"totalRefundedSet"`
+	RefundLineItems  RefundLineItemConnection   `json:"refundLineItems"`
+	Transactions     OrderTransactionConnection `json:"transactions"`
+}
+
+type RefundLineItem struct {
+	LineItem    LineItemRef `json:"lineItem"`
+	Quantity    int         `json:"quantity"`
+	RestockType string      `json:"restockType"`
+	SubtotalSet MoneyBag    `json:"subtotalSet"`
+}
+
+type RefundLineItemEdge struct {
+	Node   RefundLineItem `json:"node"`
+	Cursor string         `json:"cursor"`
+}
+
+type RefundLineItemConnection struct {
+	Edges    []RefundLineItemEdge `json:"edges"`
+	PageInfo PageInfo             `json:"pageInfo"`
+}
+
+type OrderTransaction struct {
+	ID                     string    `json:"id"`
+	Kind                   string    `json:"kind"`
+	Status                 string    `json:"status"`
+	Gateway                string    `json:"gateway"`
+	AmountSet              MoneyBag  `json:"amountSet"`
+	CreatedAt              string    `json:"createdAt"`
+	ParentTransaction      *OrderRef `json:"parentTransaction"`
+	ErrorCode              string    `json:"errorCode,omitempty"`
+	AuthorizationExpiresAt string    `json:"authorizationExpiresAt,omitempty"`
+	TotalUnsettledSet      *MoneyBag `json:"totalUnsettledSet,omitempty"`
+}
+
+type OrderTransactionEdge struct {
+	Node   OrderTransaction `json:"node"`
+	Cursor string           `json:"cursor"`
+}
+
+type OrderTransactionConnection struct {
+	Edges    []OrderTransactionEdge `json:"edges"`
+	PageInfo PageInfo               `json:"pageInfo"`
+}
+
+// SuggestedRefund is Shopify's calculation of what a refund would return and how.
+type SuggestedRefund struct {
+	AmountSet             MoneyBag                    `json:"amountSet"`
+	SubtotalSet           MoneyBag                    `json:"subtotalSet"`
+	TotalTaxSet           MoneyBag                    `json:"totalTaxSet"`
+	MaximumRefundableSet  MoneyBag                    `json:"maximumRefundableSet"`
+	Shipping              SuggestedRefundShipping     `json:"shipping"`
+	RefundLineItems       []RefundLineItem            `json:"refundLineItems"`
+	SuggestedTransactions []SuggestedOrderTransaction `json:"suggestedTransactions"`
+}
+
+type SuggestedRefundShipping struct {
+	AmountSet            MoneyBag `json:"amountSet"`
+	MaximumRefundableSet MoneyBag `json:"maximumRefundableSet"`
+}
+
+type SuggestedOrderTransaction struct {
+	Gateway              string    `json:"gateway"`
+	Kind                 string    `json:"kind"`
+	AmountSet            MoneyBag  `json:"amountSet"`
+	MaximumRefundableSet MoneyBag  `json:"maximumRefundableSet"`
+	ParentTransaction    *OrderRef `json:"parentTransaction"`
+}
+
+// ---- Draft Orders ----
+
+type DraftOrder struct {
+	ID                    string                       `json:"id"`
+	Name                  string                       `json:"name"`
+	Status                string                       `json:"status"`
+	Email                 string                       `json:"email"`
+	Note                  string                       `json:"note2"`
+	Tags                  []string                     `json:"tags"`
+	InvoiceURL            string                       `json:"invoiceUrl"`
+	InvoiceSentAt         string                       `json:"invoiceSentAt"`
+	CreatedAt             string                       `json:"createdAt"`
+	UpdatedAt             string                       `json:"updatedAt"`
+	CompletedAt           string                       `json:"completedAt"`
+	SubtotalPriceSet      MoneyBag                     `json:"subtotalPriceSet"`
+	TotalTaxSet           MoneyBag                     `json:"totalTaxSet"`
+	TotalShippingPriceSet MoneyBag                     `json:"totalShippingPriceSet"`
+	TotalPriceSet         MoneyBag                     `json:"totalPriceSet"`
+	AppliedDiscount       *DraftOrderDiscount          `json:"appliedDiscount"`
+	Customer              *OrderCustomer               `json:"customer"`
+	ShippingAddress       *MailingAddress              `json:"shippingAddress"`
+	Order                 *OrderRef                    `json:"order"`
+	LineItems             DraftOrderLineItemConnection `json:"lineItems"`
+}
+
+type DraftOrderDiscount struct {
+	Title     string   `json:"title"`
+	Value     float64  `json:"value"`
+	ValueType string   `json:"valueType"`
+	AmountSet MoneyBag `json:"amountSet"`
+}
+
+type DraftOrderLineItem struct {
+	ID                   string   `json:"id"`
+	Title                string   `json:"title"`
+	SKU                  string   `json:"sku"`
+	Quantity             int      `json:"quantity"`
+	Custom               bool     `json:"custom"`
+	OriginalUnitPriceSet MoneyBag `json:"originalUnitPriceSet"`
+	DiscountedTotalSet   MoneyBag `json:"discountedTotalSet"`
+}
+
+type DraftOrderLineItemEdge struct {
+	Node   DraftOrderLineItem `json:"node"`
+	Cursor string             `json:"cursor"`
+}
+
+type DraftOrderLineItemConnection struct {
+	Edges    []DraftOrderLineItemEdge `json:"edges"`
+	PageInfo PageInfo                 `json:"pageInfo"`
+}
+
+type DraftOrderEdge struct {
+	Node   DraftOrder `json:"node"`
+	Cursor string     `json:"cursor"`
+}
+
+type DraftOrderConnection struct {
+	Edges    []DraftOrderEdge `json:"edges"`
+	PageInfo PageInfo         `json:"pageInfo"`
+}
+
+// ---- Order Export ----
+
+// ExportOrder is an order with everything needed for accounting: line-level
+// discounts and taxes, shipping, refunds, and payment transactions with fees.
+type ExportOrder struct {
+	ID            string                       `json:"id"`
+	Name          string                       `json:"name"`
+	CreatedAt     string                       `json:"createdAt"`
+	ProcessedAt   string                       `json:"processedAt"`
+	CurrencyCode  string                       `json:"currencyCode"`
+	TaxesIncluded bool                         `json:"taxesIncluded"`
+	Email         string                       `json:"email"`
+	TotalPriceSet MoneyBag                     `json:"totalPriceSet"`
+	DiscountCodes []string                     `json:"discountCodes"`
+	LineItems     ExportLineItemConnection     `json:"lineItems"`
+	ShippingLines ExportShippingLineConnection `json:"shippingLines"`
+	Refunds       []ExportRefund               `json:"refunds"`
+	Transactions  []ExportTransaction          `json:"transactions"`
+}
+
+type ExportLineItem struct {
+	ID                   string               `json:"id"`
+	Title                string               `json:"title"`
+	VariantTitle         string               `json:"variantTitle"`
+	SKU                  string               `json:"sku"`
+	Vendor               string               `json:"vendor"`
+	Quantity             int                  `json:"quantity"`
+	OriginalUnitPriceSet MoneyBag             `json:"originalUnitPriceSet"`
+	DiscountAllocations  []DiscountAllocation `json:"discountAllocations"`
+	TaxLines             []TaxLine            `json:"taxLines"`
+}
+
+type ExportLineItemEdge struct {
+	Node ExportLineItem `json:"node"`
+}
+
+type ExportLineItemConnection struct {
+	Edges    []ExportLineItemEdge `json:"edges"`
+	PageInfo PageInfo             `json:"pageInfo"`
+}
+
+type ExportShippingLine struct {
+	Title               string               `json:"title"`
+	Code                string               `json:"code"`
+	OriginalPriceSet    MoneyBag             `json:"originalPriceSet"`
+	DiscountedPriceSet  MoneyBag             `json:"discountedPriceSet"`
+	DiscountAllocations []DiscountAllocation `json:"discountAllocations"`
+	TaxLines            []TaxLine            `json:"taxLines"`
+}
+
+type ExportShippingLineEdge struct {
+	Node ExportShippingLine `json:"node"`
+}
+
+type ExportShippingLineConnection struct {
+	Edges    []ExportShippingLineEdge `json:"edges"`
+	PageInfo PageInfo                 `json:"pageInfo"`
+}
+
+// TaxLine is a tax charged by one jurisdiction, e.g. "CA State Tax" at 6%.
+type TaxLine struct {
+	Title          string   `json:"title"`
+	RatePercentage float64  `json:"ratePercentage"`
+	PriceSet       MoneyBag `json:"priceSet"`
+}
+
+// DiscountAllocation is the part of a discount applied to one line.
+type DiscountAllocation struct {
+	AllocatedAmountSet  MoneyBag          `json:"allocatedAmountSet"`
+	DiscountApplication DiscountReference `json:"discountApplication"`
+}
+
+// DiscountReference identifies a discount application by code (code discounts)
+// or title (automatic, manual, and script discounts).
+type DiscountReference struct {
+	Code  string `json:"code,omitempty"`
+	Title string `json:"title,omitempty"`
+}
+
+type ExportRefund struct {
+	ID                  string                         `json:"id"`
+	CreatedAt           string                         `json:"createdAt"`
+	Note                string                         `json:"note"`
+	TotalRefundedSet    MoneyBag                       `json:"totalRefundedSet"`
+	RefundLineItems     ExportRefundLineItemConnection `json:"refundLineItems"`
+	RefundShippingLines ExportRefundShippingConnection `json:"refundShippingLines"`
+	Transactions        OrderTransactionConnection     `json:"transactions"`
+}
+
+type ExportRefundLineItem struct {
+	LineItem    LineItemRef `json:"lineItem"`
+	Quantity    int         `json:"quantity"`
+	SubtotalSet MoneyBag    `json:"subtotalSet"`
+	TotalTaxSet MoneyBag    `json:"totalTaxSet"`
+}
+
+type ExportRefundLineItemEdge struct {
+	Node ExportRefundLineItem `json:"node"`
+}
+
+type ExportRefundLineItemConnection struct {
+	Edges    []ExportRefundLineItemEdge `json:"edges"`
+	PageInfo PageInfo                   `json:"pageInfo"`
+}
+
+type ExportRefundShippingLine struct {
+	SubtotalAmountSet MoneyBag `json:"subtotalAmountSet"`
+	TaxAmountSet      MoneyBag `json:"taxAmountSet"`
+}
+
+type ExportRefundShippingEdge struct {
+	Node ExportRefundShippingLine `json:"node"`
+}
+
+type ExportRefundShippingConnection struct {
+	Edges    []ExportRefundShippingEdge `json:"edges"`
+	PageInfo PageInfo                   `json:"pageInfo"`
+}
+
+type ExportTransaction struct {
+	ID          string           `json:"id"`
+	Kind        string           `json:"kind"`
+	Status      string           `json:"status"`
+	Gateway     string           `json:"gateway"`
+	ProcessedAt string           `json:"processedAt"`
+	AmountSet   MoneyBag         `json:"amountSet"`
+	Fees        []TransactionFee `json:"fees"`
+}
+
+// TransactionFee is a payment processing fee (Shopify Payments only).
+type TransactionFee struct {
+	Type      string  `json:"type"`
+	RateName  string  `json:"rateName"`
+	Amount    MoneyV2 `json:"amount"`
+	TaxAmount MoneyV2 `json:"taxAmount"`
+}
+
+type ExportOrderEdge struct {
+	Node   ExportOrder `json:"node"`
+	Cursor string      `json:"cursor"`
+}
+
+type ExportOrderConnection struct {
+	Edges    []ExportOrderEdge `json:"edges"`
+	PageInfo PageInfo          `json:"pageInfo"`
+}
+
+// ---- Order Timeline ----
+
+// OrderTimeline is the raw material for an order's history: events plus the
+// fulfillments, refunds, and transactions they refer to.
+type OrderTimeline struct {
+	ID           string                `json:"id"`
+	Name         string                `json:"name"`
+	Note         string                `json:"note"`
+	CreatedAt    string                `json:"createdAt"`
+	ClosedAt     string                `json:"closedAt"`
+	CancelledAt  string                `json:"cancelledAt"`
+	CancelReason string                `json:"cancelReason"`
+	Events       OrderEventConnection  `json:"events"`
+	Fulfillments []TimelineFulfillment `json:"fulfillments"`
+	Refunds      []Refund              `json:"refunds"`
+	Transactions []OrderTransaction    `json:"transactions"`
+}
+
+// OrderEvent is an entry from the order's event log. Action is set for basic
+// events; Author and RawMessage for staff comments.
+type OrderEvent struct {
+	ID              string    `json:"id"`
+	CreatedAt       string    `json:"createdAt"`
+	Message         string    `json:"message"`
+	AppTitle        string    `json:"appTitle"`
+	AttributeToApp  bool      `json:"attributeToApp"`
+	AttributeToUser bool      `json:"attributeToUser"`
+	CriticalAlert   bool      `json:"criticalAlert"`
+	Action          string    `json:"action,omitempty"`
+	RawMessage      string    `json:"rawMessage,omitempty"`
+	Author          *StaffRef `json:"author,omitempty"`
+}
+
+type StaffRef struct {
+	Name string `json:"name"`
+}
+
+type OrderEventEdge struct {
+	Node   OrderEvent `json:"node"`
+	Cursor string     `json:"cursor"`
+}
+
+type OrderEventConnection struct {
+	Edges    []OrderEventEdge `json:"edges"`
+	PageInfo PageInfo         `json:"pageInfo"`
+}
+
+type TimelineFulfillment struct {
+	ID            string         `json:"id"`
+	Name          string         `json:"name"`
+	Status        string         `json:"status"`
+	DisplayStatus string         `json:"displayStatus"`
+	CreatedAt     string         `json:"createdAt"`
+	DeliveredAt   string         `json:"deliveredAt"`
+	Location      *LocationRef   `json:"location"`
+	TrackingInfo  []TrackingInfo `json:"trackingInfo"`
+}
+
+type TrackingInfo struct {
+	Company string `json:"company"`
+	Number  string `json:"number"`
+	URL     string `json:"url"`
+}
+
+type LocationRef struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// ---- Returns ----
+
+// Return is a customer return. Status is REQUESTED, OPEN, DECLINED, CLOSED, or CANCELED.
+type Return struct {
+	ID                       string                            `json:"id"`
+	Name                     string                            `json:"name"`
+	Status                   string                            `json:"status"`
+	CreatedAt                string                            `json:"createdAt"`
+	ClosedAt                 string                            `json:"closedAt"`
+	TotalQuantity            int                               `json:"totalQuantity"`
+	Order                    *OrderRef                         `json:"order"`
+	Decline                  *ReturnDecline                    `json:"decline"`
+	ReturnLineItems          ReturnLineItemConnection          `json:"returnLineItems"`
+	ReverseFulfillmentOrders ReverseFulfillmentOrderConnection `json:"reverseFulfillmentOrders"`
+	Refunds                  RefundConnection                  `json:"refunds"`
+}
+
+type ReturnDecline struct {
+	Reason string `json:"reason"`
+	Note   string `json:"note"`
+}
+
+type ReturnLineItem struct {
+	ID                  string               `json:"id"`
+	Quantity            int                  `json:"quantity"`
+	ReturnReason        string               `json:"returnReason"`
+	CustomerNote        string               `json:"customerNote"`
+	FulfillmentLineItem *FulfillmentLineItem `json:"fulfillmentLineItem"`
+}
+
+type FulfillmentLineItem struct {
+	ID       string      `json:"id"`
+	Quantity int         `json:"quantity,omitempty"`
+	LineItem LineItemRef `json:"lineItem"`
+}
+
+type ReturnLineItemEdge struct {
+	Node ReturnLineItem `json:"node"`
+}
+
+type ReturnLineItemConnection struct {
+	Edges    []ReturnLineItemEdge `json:"edges"`
+	PageInfo PageInfo             `json:"pageInfo"`
+}
+
+type ReverseFulfillmentOrder struct {
+	ID        string                                    `json:"id"`
+	Status    string                                    `json:"status"`
+	LineItems ReverseFulfillmentOrderLineItemConnection `json:"lineItems"`
+}
+
+type ReverseFulfillmentOrderLineItem struct {
+	ID                  string               `json:"id"`
+	TotalQuantity       int                  `json:"totalQuantity"`
+	FulfillmentLineItem *FulfillmentLineItem `json:"fulfillmentLineItem"`
+	Dispositions        []ReverseDisposition `json:"dispositions"`
+}
+
+// ReverseDisposition records what happened to returned units. Type is RESTOCKED,
+// PROCESSING_REQUIRED, NOT_RESTOCKED, or MISSING.
+type ReverseDisposition struct {
+	Quantity int          `json:"quantity"`
+	Type     string       `json:"type"`
+	Location *LocationRef `json:"location"`
+}
+
+type ReverseFulfillmentOrderLineItemEdge struct {
+	Node ReverseFulfillmentOrderLineItem `json:"node"`
+}
+
+type ReverseFulfillmentOrderLineItemConnection struct {
+	Edges    []ReverseFulfillmentOrderLineItemEdge `json:"edges"`
+	PageInfo PageInfo                              `json:"pageInfo"`
+}
+
+type ReverseFulfillmentOrderEdge struct {
+	Node ReverseFulfillmentOrder `json:"node"`
+}
+
+type ReverseFulfillmentOrderConnection struct {
+	Edges    []ReverseFulfillmentOrderEdge `json:"edges"`
+	PageInfo PageInfo                      `json:"pageInfo"`
+}
+
+type ReturnEdge struct {
+	Node   Return `json:"node"`
+	Cursor string `json:"cursor"`
+}
+
+type ReturnConnection struct {
+	Edges    []ReturnEdge `json:"edges"`
+	PageInfo PageInfo     `json:"pageInfo"`
+}
+
+type RefundEdge struct {
+	Node Refund `json:"node"`
+}
+
+type RefundConnection struct {
+	Edges    []RefundEdge `json:"edges"`
+	PageInfo PageInfo     `json:"pageInfo"`
+}
+
+// ReturnableLine is a fulfilled line item quantity that can still be returned.
+type ReturnableLine struct {
+	FulfillmentLineItemID string      `json:"fulfillmentLineItemId"`
+	Quantity              int         `json:"quantity"`
+	LineItem              LineItemRef `json:"lineItem"`
+}
+
+
+// ---- Abandoned Checkouts ----
+
+// AbandonedCheckout is a checkout the customer left before paying.
+// AbandonedCheckoutURL takes them back to it with the cart restored.
+type AbandonedCheckout struct {
+	ID                   string                              `json:"id"`
+	Name                 string                              `json:"name"`
+	CreatedAt            string                              `json:"createdAt"`
+	UpdatedAt            string                              `json:"updatedAt"`
+	CompletedAt          *string                             `json:"completedAt"`
+	AbandonedCheckoutURL string                              `json:"abandonedCheckoutUrl"`
+	SubtotalPriceSet     MoneyBag                            `json:"subtotalPriceSet"`
+	TotalPriceSet        MoneyBag                            `json:"totalPriceSet"`
+	Customer             *Customer                           `json:"customer"`
+	LineItems            AbandonedCheckoutLineItemConnection `json:"lineItems"`
+}
+
+type AbandonedCheckoutLineItem struct {
+	ID                    string   `json:"id"`
+	Title                 string   `json:"title"`
+	VariantTitle          string   `json:"variantTitle"`
+	SKU                   string   `json:"sku"`
+	Quantity              int      `json:"quantity"`
+	OriginalTotalPriceSet MoneyBag `json:"originalTotalPriceSet"`
+}
+
+type AbandonedCheckoutLineItemEdge struct {
+	Node AbandonedCheckoutLineItem `json:"node"`
+}
+
+type AbandonedCheckoutLineItemConnection struct {
+	Edges []AbandonedCheckoutLineItemEdge `json:"edges"`
+}
+
+type AbandonedCheckoutEdge struct {
+	Node   AbandonedCheckout `json:"node"`
+	Cursor string            `json:"cursor"`
+}
+
+type AbandonedCheckoutConnection struct {
+	Edges    []AbandonedCheckoutEdge `json:"edges"`
+	PageInfo PageInfo                `json:"pageInfo"`
+}
+
+
+// ---- Fulfillment & Carrier Services ----
+
+// FulfillmentService is an app or warehouse that fulfills orders on the merchant's
+// behalf. Shopify sends fulfillment requests, and (with TrackingSupport or
+// InventoryManagement) tracking and stock queries, to CallbackURL.
+type FulfillmentService struct {
+	ID                     string       `json:"id"`
+	ServiceName            string       `json:"serviceName"`
+	Handle                 string       `json:"handle"`
+	Type                   string       `json:"type"`
+	CallbackURL            string       `json:"callbackUrl"`
+	InventoryManagement    bool         `json:"inventoryManagement"`
+	TrackingSupport        bool         `json:"trackingSupport"`
+	PermitsSkuSharing      bool         `json:"permitsSkuSharing"`
+	RequiresShippingMethod bool         `json:"requiresShippingMethod"`
+	Location               *LocationRef `json:"location"`
+}
+
+// CarrierService is an endpoint that returns shipping rates at checkout.
+type CarrierService struct {
+	ID                       string `json:"id"`
+	Name                     string `json:"name"`
+	FormattedName            string `json:"formattedName"`
+	CallbackURL              string `json:"callbackUrl"`
+	Active                   bool   `json:"active"`
+	SupportsServiceDiscovery bool   `json:"supportsServiceDiscovery"`
+}
+
+type CarrierServiceEdge struct {
+	Node   CarrierService `json:"node"`
+	Cursor string         `json:"cursor"`
+}
+
+type CarrierServiceConnection struct {
+	Edges    []CarrierServiceEdge `json:"edges"`
+	PageInfo PageInfo             `json:"pageInfo"`
+}
+
+
+// ---- Delivery Profiles ----
+
+// DeliveryProfile groups products with the shipping zones and rates that apply to
+// them, per group of origin locations.
+type DeliveryProfile struct {
+	ID                           string                         `json:"id"`
+	Name                         string                         `json:"name"`
+	Default                      bool                           `json:"default"`
+	ActiveMethodDefinitionsCount int                            `json:"activeMethodDefinitionsCount"`
+	OriginLocationCount          int                            `json:"originLocationCount"`
+	LocationsWithoutRatesCount   int                            `json:"locationsWithoutRatesCount"`
+	ZoneCountryCount             int                            `json:"zoneCountryCount"`
+	ProductVariantsCount         *ProductsCount                 `json:"productVariantsCount,omitempty"`
+	ProfileLocationGroups        []DeliveryProfileLocationGroup `json:"profileLocationGroups,omitempty"`
+}
+
+type DeliveryProfileLocationGroup struct {
+	LocationGroup      DeliveryLocationGroup               `json:"locationGroup"`
+	LocationGroupZones DeliveryLocationGroupZoneConnection `json:"locationGroupZones"`
+}
+
+type DeliveryLocationGroup struct {
+	ID        string                `json:"id"`
+	Locations LocationRefConnection `json:"locations"`
+}
+
+type LocationRefEdge struct {
+	Node LocationRef `json:"node"`
+}
+
+type LocationRefConnection struct {
+	Edges    []LocationRefEdge `json:"edges"`
+	PageInfo PageInfo          `json:"pageInfo"`
+}
+
+type DeliveryLocationGroupZone struct {
+	Zone              DeliveryZone                       `json:"zone"`
+	MethodDefinitions DeliveryMethodDefinitionConnection `json:"methodDefinitions"`
+}
+
+type DeliveryLocationGroupZoneEdge struct {
+	Node   DeliveryLocationGroupZone `json:"node"`
+	Cursor string                    `json:"cursor"`
+}
+
+type DeliveryLocationGroupZoneConnection struct {
+	Edges    []DeliveryLocationGroupZoneEdge `json:"edges"`
+	PageInfo PageInfo                        `json:"pageInfo"`
+}
+
+type DeliveryZone struct {
+	ID        string            `json:"id"`
+	Name      string            `json:"name"`
+	Countries []DeliveryCountry `json:"countries"`
+}
+
+type DeliveryCountry struct {
+	ID        string              `json:"id"`
+	Name      string              `json:"name"`
+	Code      DeliveryCountryCode `json:"code"`
+	Provinces []DeliveryProvince  `json:"provinces"`
+}
+
+type DeliveryCountryCode struct {
+	CountryCode string `json:"countryCode"`
+	RestOfWorld bool   `json:"restOfWorld"`
+}
+
+type DeliveryProvince struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	Code string `json:"code"`
+}
+
+// DeliveryMethodDefinition is a shipping rate offered in a zone, with the
+// conditions (order weight or price ranges) under which it applies.
+type DeliveryMethodDefinition struct {
+	ID               string               `json:"id"`
+	Name             string               `json:"name"`
+	Description      string               `json:"description"`
+	Active           bool                 `json:"active"`
+	RateProvider     DeliveryRateProvider `json:"rateProvider"`
+	MethodConditions []DeliveryCondition  `json:"methodConditions"`
+}
+
+// DeliveryRateProvider is either a flat rate (DeliveryRateDefinition, with Price)
+// or a carrier-calculated rate (DeliveryParticipant, with CarrierService).
+type DeliveryRateProvider struct {
+	Typename            string              `json:"__typename"`
+	ID                  string              `json:"id"`
+	Price               *MoneyV2            `json:"price,omitempty"`
+	CarrierService      *DeliveryCarrierRef `json:"carrierService,omitempty"`
+	FixedFee            *MoneyV2            `json:"fixedFee,omitempty"`
+	PercentageOfRateFee float64             `json:"percentageOfRateFee,omitempty"`
+}
+
+type DeliveryCarrierRef struct {
+	ID            string `json:"id"`
+	FormattedName string `json:"formattedName"`
+}
+
+type DeliveryMethodDefinitionEdge struct {
+	Node DeliveryMethodDefinition `json:"node"`
+}
+
+type DeliveryMethodDefinitionConnection struct {
+	Edges    []DeliveryMethodDefinitionEdge `json:"edges"`
+	PageInfo PageInfo                       `json:"pageInfo"`
+}
+
+// DeliveryCondition limits a rate to orders whose Field (TOTAL_WEIGHT or
+// TOTAL_PRICE) is GREATER_THAN_OR_EQUAL_TO or LESS_THAN_OR_EQUAL_TO the criteria.
+type DeliveryCondition struct {
+	ID                string                    `json:"id"`
+	Field             string                    `json:"field"`
+	Operator          string                    `json:"operator"`
+	ConditionCriteria DeliveryConditionCriteria `json:"conditionCriteria"`
+}
+
+// DeliveryConditionCriteria is a MoneyV2 (Amount, CurrencyCode) or a Weight
+// (Value, Unit), told apart by Typename.
+type DeliveryConditionCriteria struct {
+	Typename     string  `json:"__typename"`
+	Amount       string  `json:"amount,omitempty"`
+	CurrencyCode string  `json:"currencyCode,omitempty"`
+	Value        float64 `json:"value,omitempty"`
+	Unit         string  `json:"unit,omitempty"`
+}
+
+type DeliveryProfileEdge struct {
+	Node   DeliveryProfile `json:"node"`
+	Cursor string          `json:"cursor"`
+}
+
+type DeliveryProfileConnection struct {
+	Edges    []DeliveryProfileEdge `json:"edges"`
+	PageInfo PageInfo              `json:"pageInfo"`
+}
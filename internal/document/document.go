@@ -0,0 +1,166 @@
+// Package document renders printable order documents (packing slips and
+// invoices) as HTML or PDF without any external service.
+package document
+
+import (
+	"embed"
+	"fmt"
+	"html/template"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/the20100/shopify-admin-cli/internal/api"
+)
+
+// Document types.
+const (
+	PackingSlip = "packing-slip"
+	Invoice     = "invoice"
+)
+
+//go:embed templates/*.html.tmpl
+var templates embed.FS
+
+// Data is what templates receive: the document type and title, the shop (with
+// its billing address), and the orders, one document per order.
+type Data struct {
+	Type        string
+	Title       string
+	Shop        *api.Shop
+	Orders      []*api.Order
+	GeneratedAt time.Time
+}
+
+// NewData builds template data for a document type.
+func NewData(docType string, shop *api.Shop, orders []*api.Order) (Data, error) {
+	var title string
+	switch docType {
+	case PackingSlip:
+		title = "Packing slip"
+	case Invoice:
+		title = "Invoice"
+	default:
+		return Data{}, fmt.Errorf("unknown document type %q: expected %s or %s", docType, PackingSlip, Invoice)
+	}
+	return Data{Type: docType, Title: title, Shop: shop, Orders: orders, GeneratedAt: time.Now()}, nil
+}
+
+// Funcs are the helpers available to templates.
+var Funcs = template.FuncMap{
+	"money":         Money,
+	"date":          Date,
+	"address":       AddressLines,
+	"shopAddress":   ShopAddressLines,
+	"itemTitle":     ItemTitle,
+	"hasAmount":     HasAmount,
+	"upper":         strings.ToUpper,
+	"lower":         strings.ToLower,
+	"add":           func(a, b int) int { return a + b },
+	"totalQuantity": TotalQuantity,
+}
+
+// RenderHTML renders d with tmpl, a Go html/template. An empty tmpl uses the
+// built-in template for d.Type.
+func RenderHTML(w io.Writer, d Data, tmpl string) error {
+	name := d.Type
+	if tmpl == "" {
+		b, err := templates.ReadFile("templates/" + d.Type + ".html.tmpl")
+		if err != nil {
+			return err
+		}
+		tmpl = string(b)
+	} else {
+		name = "custom"
+	}
+	t, err := template.New(name).Funcs(Funcs).Parse(tmpl)
+	if err != nil {
+		return fmt.Errorf("parsing template: %w", err)
+	}
+	return t.Execute(w, d)
+}
+
+// Money formats a MoneyBag as "12.50 USD", or "" when empty.
+func Money(b api.MoneyBag) string {
+	if b.ShopMoney.Amount == "" {
+		return ""
+	}
+	f, err := strconv.ParseFloat(b.ShopMoney.Amount, 64)
+	if err != nil {
+		return b.ShopMoney.Amount + " " + b.ShopMoney.CurrencyCode
+	}
+	return fmt.Sprintf("%.2f %s", f, b.ShopMoney.CurrencyCode)
+}
+
+// HasAmount reports whether a MoneyBag holds a non-zero amount.
+func HasAmount(b api.MoneyBag) bool {
+	f, _ := strconv.ParseFloat(b.ShopMoney.Amount, 64)
+	return f != 0
+}
+
+// Date formats an ISO-8601 timestamp as "January 2, 2006".
+func Date(s string) string {
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return s
+	}
+	return t.Format("January 2, 2006")
+}
+
+// AddressLines returns the printable lines of a mailing address.
+func AddressLines(a *api.MailingAddress) []string {
+	if a == nil {
+		return nil
+	}
+	return compact(
+		strings.TrimSpace(a.FirstName+" "+a.LastName),
+		a.Address1,
+		a.Address2,
+		strings.TrimSpace(strings.Join(compact(a.City, a.Province, a.Zip), " ")),
+		a.Country,
+		a.Phone,
+	)
+}
+
+// ShopAddressLines returns the printable lines of the shop's billing address.
+func ShopAddressLines(a *api.ShopAddress) []string {
+	if a == nil {
+		return nil
+	}
+	return compact(
+		a.Company,
+		a.Address1,
+		a.Address2,
+		strings.TrimSpace(strings.Join(compact(a.City, a.Province, a.Zip), " ")),
+		a.Country,
+		a.Phone,
+	)
+}
+
+// ItemTitle returns a line item's title with its variant title, if any.
+func ItemTitle(li api.LineItem) string {
+	if li.VariantTitle == "" || li.VariantTitle == "Default Title" {
+		return li.Title
+	}
+	return li.Title + " - " + li.VariantTitle
+}
+
+// TotalQuantity returns the number of units on an order.
+func TotalQuantity(o *api.Order) int {
+	n := 0
+	for _, e := range o.LineItems.Edges {
+		n += e.Node.Quantity
+	}
+	return n
+}
+
+func compact(parts ...string) []string {
+	var out []string
+	for _, p := range parts {
+		if p = strings.TrimSpace(p); p != "" {
+			out = append(out, p)
+		}
+	}
+	return out
+}
@@ -0,0 +1,336 @@
+package document
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/the20100/shopify-admin-cli/internal/api"
+)
+
+// US Letter in points, with the margins used by the built-in layout.
+const (
+	pageWidth  = 612.0
+	pageHeight = 792.0
+	margin     = 48.0
+)
+
+// helveticaWidths are the Helvetica glyph widths (per 1000 em) for ASCII 32–126,
+// from the standard Adobe font metrics. Other characters use 556.
+var helveticaWidths = [95]int{
+	278, 278, 355, 556, 556, 889, 667, 191, 333, 333, 389, 584, 278, 333, 278, 278, // space–/
+	556, 556, 556, 556, 556, 556, 556, 556, 556, 556, // 0–9
+	278, 278, 584, 584, 584, 556, 1015, // :–@
+	667, 667, 722, 722, 667, 611, 778, 722, 278, 500, 667, 556, 833, // A–M
+	722, 778, 667, 778, 722, 667, 611, 722, 667, 944, 667, 667, 611, // N–Z
+	278, 278, 278, 469, 556, 333, // [–`
+	556, 556, 500, 556, 556, 278, 556, 556, 222, 222, 500, 222, 833, // a–m
+	556, 556, 556, 556, 333, 500, 278, 556, 500, 722, 500, 500, 500, // n–z
+	334, 260, 334, 584, // {–~
+}
+
+// textWidth returns the width of s in points at size, in Helvetica. Bold text is
+// about 5% wider.
+func textWidth(s string, size float64, bold bool) float64 {
+	w := 0
+	for _, r := range s {
+		if r >= 32 && r <= 126 {
+			w += helveticaWidths[r-32]
+		} else {
+			w += 556
+		}
+	}
+	f := float64(w) * size / 1000
+	if bold {
+		f *= 1.05
+	}
+	return f
+}
+
+// pdfString encodes s as a PDF literal string in WinAnsiEncoding. Characters
+// outside Latin-1 are replaced with '?'.
+func pdfString(s string) string {
+	var b strings.Builder
+	b.WriteByte('(')
+	for len(s) > 0 {
+		r, size := utf8.DecodeRuneInString(s)
+		s = s[size:]
+		switch {
+		case r == '(' || r == ')' || r == '\\':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case r >= 32 && r <= 126:
+			b.WriteRune(r)
+		case r == '€':
+			b.WriteString(`\200`)
+		case r >= 160 && r <= 255:
+			fmt.Fprintf(&b, `\%03o`, r)
+		default:
+			b.WriteByte('?')
+		}
+	}
+	b.WriteByte(')')
+	return b.String()
+}
+
+// pdfWriter accumulates pages of text and rules and writes a PDF 1.4 file using
+// the built-in Helvetica fonts, so nothing needs to be embedded.
+type pdfWriter struct {
+	pages []*bytes.Buffer
+	page  *bytes.Buffer
+	y     float64 // baseline of the next line, from the bottom of the page
+}
+
+func (p *pdfWriter) newPage() {
+	p.page = &bytes.Buffer{}
+	p.pages = append(p.pages, p.page)
+	p.y = pageHeight - margin
+}
+
+// text draws s with its left edge at x on the current baseline.
+func (p *pdfWriter) text(x float64, s string, size float64, bold bool) {
+	font := "F1"
+	if bold {
+		font = "F2"
+	}
+	fmt.Fprintf(p.page, "BT /%s %.1f Tf %.2f %.2f Td %s Tj ET\n", font, size, x, p.y, pdfString(s))
+}
+
+// textRight draws s with its right edge at x.
+func (p *pdfWriter) textRight(x float64, s string, size float64, bold bool) {
+	p.text(x-textWidth(s, size, bold), s, size, bold)
+}
+
+// rule draws a horizontal line across the page just below the current baseline.
+func (p *pdfWriter) rule(width float64) {
+	fmt.Fprintf(p.page, "%.1f w %.2f %.2f m %.2f %.2f l S\n", width, margin, p.y-4, pageWidth-margin, p.y-4)
+}
+
+// fit truncates s with "..." so that it fits in width points.
+func fit(s string, width, size float64) string {
+	if textWidth(s, size, false) <= width {
+		return s
+	}
+	r := []rune(s)
+	for len(r) > 0 && textWidth(string(r)+"...", size, false) > width {
+		r = r[:len(r)-1]
+	}
+	return string(r) + "..."
+}
+
+func (p *pdfWriter) writeTo(w io.Writer) error {
+	var out bytes.Buffer
+	var offsets []int
+	obj := func(body string) {
+		offsets = append(offsets, out.Len())
+		fmt.Fprintf(&out, "%d 0 obj\n%s\nendobj\n", len(offsets), body)
+	}
+	out.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+
+	// Objects: 1 catalog, 2 page tree, 3–4 fonts, then a page and its content
+	// stream for each page.
+	kids := make([]string, len(p.pages))
+	for i := range p.pages {
+		kids[i] = fmt.Sprintf("%d 0 R", 5+2*i)
+	}
+	obj("<< /Type /Catalog /Pages 2 0 R >>")
+	obj(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(p.pages)))
+	obj("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>")
+	obj("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica-Bold /Encoding /WinAnsiEncoding >>")
+	for i, page := range p.pages {
+		obj(fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %.0f %.0f] /Resources << /Font << /F1 3 0 R /F2 4 0 R >> >> /Contents %d 0 R >>",
+			pageWidth, pageHeight, 6+2*i))
+		obj(fmt.Sprintf("<< /Length %d >>\nstream\n%sendstream", page.Len(), page.String()))
+	}
+
+	xref := out.Len()
+	fmt.Fprintf(&out, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+	for _, off := range offsets {
+		fmt.Fprintf(&out, "%010d 00000 n \n", off)
+	}
+	fmt.Fprintf(&out, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(offsets)+1, xref)
+	_, err := w.Write(out.Bytes())
+	return err
+}
+
+// column is a table column: its header, left edge, width, and alignment.
+type column struct {
+	header string
+	x      float64
+	width  float64
+	right  bool
+}
+
+// RenderPDF renders d with the built-in layout, one or more pages per order.
+// Product images are not included in PDF output.
+func RenderPDF(w io.Writer, d Data) error {
+	p := &pdfWriter{}
+	for _, o := range d.Orders {
+		renderOrderPDF(p, d, o)
+	}
+	if len(p.pages) == 0 {
+		p.newPage()
+	}
+	return p.writeTo(w)
+}
+
+func renderOrderPDF(p *pdfWriter, d Data, o *api.Order) {
+	const lineHeight = 14.0
+	p.newPage()
+
+	// Header: shop on the left, document title and order on the right.
+	top := p.y
+	p.text(margin, d.Shop.Name, 16, true)
+	p.y -= 18
+	for _, l := range ShopAddressLines(d.Shop.BillingAddress) {
+		p.text(margin, l, 9, false)
+		p.y -= 12
+	}
+	left := p.y
+	p.y = top
+	p.textRight(pageWidth-margin, d.Title, 16, true)
+	p.y -= 18
+	p.textRight(pageWidth-margin, "Order "+o.Name, 10, false)
+	p.y -= 13
+	p.textRight(pageWidth-margin, Date(o.CreatedAt), 10, false)
+	if d.Type == Invoice {
+		p.y -= 13
+		p.textRight(pageWidth-margin, "Payment: "+strings.ToLower(o.FinancialStatus), 10, false)
+	}
+	if left < p.y {
+		p.y = left
+	}
+	p.y += 6
+	p.rule(1.5)
+	p.y -= 28
+
+	// Addresses.
+	blockTop := p.y
+	addressBlock := func(x float64, title string, lines []string) float64 {
+		p.y = blockTop
+		p.text(x, strings.ToUpper(title), 9, true)
+		p.y -= lineHeight
+		if len(lines) == 0 {
+			lines = []string{"-"}
+		}
+		for _, l := range lines {
+			p.text(x, fit(l, 240, 10), 10, false)
+			p.y -= 12
+		}
+		return p.y
+	}
+	bottom := addressBlock(margin, "Ship to", AddressLines(o.ShippingAddress))
+	if d.Type == Invoice {
+		bill := AddressLines(o.BillingAddress)
+		if len(bill) == 0 && o.Customer != nil {
+			bill = compact(o.Customer.FirstName + " " + o.Customer.LastName)
+		}
+		if o.Email != "" {
+			bill = append(bill, o.Email)
+		}
+		if y := addressBlock(margin+270, "Bill to", bill); y < bottom {
+			bottom = y
+		}
+	}
+	p.y = bottom - 16
+
+	// Line items.
+	var cols []column
+	if d.Type == Invoice {
+		cols = []column{
+			{"Item", margin, 230, false},
+			{"SKU", margin + 236, 100, false},
+			{"Qty", margin + 340, 40, true},
+			{"Unit price", margin + 384, 66, true},
+			{"Total", margin + 454, 62, true},
+		}
+	} else {
+		cols = []column{
+			{"Qty", margin, 36, true},
+			{"Item", margin + 52, 320, false},
+			{"SKU", margin + 380, 136, false},
+		}
+	}
+	header := func() {
+		for _, c := range cols {
+			if c.right {
+				p.textRight(c.x+c.width, strings.ToUpper(c.header), 8, true)
+			} else {
+				p.text(c.x, strings.ToUpper(c.header), 8, true)
+			}
+		}
+		p.rule(0.75)
+		p.y -= 18
+	}
+	continued := func() {
+		p.newPage()
+		p.text(margin, d.Title+" - "+o.Name+" (continued)", 10, true)
+		p.y -= 24
+	}
+	header()
+	for _, e := range o.LineItems.Edges {
+		li := e.Node
+		if p.y < margin+90 {
+			continued()
+			header()
+		}
+		var values []string
+		if d.Type == Invoice {
+			values = []string{ItemTitle(li), li.SKU, fmt.Sprintf("%d", li.Quantity), Money(li.OriginalUnitPriceSet), Money(li.DiscountedTotalSet)}
+		} else {
+			values = []string{fmt.Sprintf("%d", li.Quantity), ItemTitle(li), li.SKU}
+		}
+		for i, c := range cols {
+			v := fit(values[i], c.width, 10)
+			if c.right {
+				p.textRight(c.x+c.width, v, 10, d.Type == PackingSlip && i == 0)
+			} else {
+				p.text(c.x, v, 10, false)
+			}
+		}
+		p.y -= lineHeight + 4
+	}
+
+	// Totals or item count.
+	p.y -= 6
+	if d.Type == Invoice {
+		rows := [][2]string{{"Subtotal", Money(o.SubtotalPriceSet)}}
+		if HasAmount(o.TotalDiscountsSet) {
+			rows = append(rows, [2]string{"Includes discounts", Money(o.TotalDiscountsSet)})
+		}
+		rows = append(rows,
+			[2]string{"Shipping", Money(o.TotalShippingPriceSet)},
+			[2]string{"Tax", Money(o.TotalTaxSet)},
+		)
+		for _, r := range rows {
+			p.text(pageWidth-margin-200, r[0], 10, false)
+			p.textRight(pageWidth-margin, r[1], 10, false)
+			p.y -= lineHeight
+		}
+		p.y -= 4
+		p.text(pageWidth-margin-200, "Total", 12, true)
+		p.textRight(pageWidth-margin, Money(o.TotalPriceSet), 12, true)
+		p.y -= lineHeight
+	} else {
+		p.text(margin, fmt.Sprintf("%d items", TotalQuantity(o)), 9, false)
+		p.y -= lineHeight
+	}
+
+	if o.Note != "" {
+		p.y -= 12
+		if p.y < margin+lineHeight {
+			continued()
+		}
+		p.text(margin, "NOTE", 9, true)
+		p.y -= lineHeight
+		for _, l := range strings.Split(o.Note, "\n") {
+			if p.y < margin {
+				continued()
+			}
+			p.text(margin, fit(l, pageWidth-2*margin, 10), 10, false)
+			p.y -= 12
+		}
+	}
+}
@@ -1,8 +1,10 @@
 package output
 
 import (
+	"encoding/csv"
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 	"strings"
 	"text/tabwriter"
@@ -66,6 +68,18 @@ func PrintTable(headers []string, rows [][]string) {
 	}
 }
 
+// WriteCSV writes headers and rows as CSV to w.
+func WriteCSV(w io.Writer, headers []string, rows [][]string) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(headers); err != nil {
+		return err
+	}
+	if err := cw.WriteAll(rows); err != nil {
+		return err
+	}
+	return cw.Error()
+}
+
 // PrintKeyValue prints a two-column key-value table.
 func PrintKeyValue(rows [][]string) {
 	w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)